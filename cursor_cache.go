@@ -0,0 +1,125 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"image"
+)
+
+// CursorCache memoizes Cursor handles created by a CursorFactory, keyed by
+// the content hash of the cursor's image (and, for animated cursors, its
+// frame durations), so a caller that applies a cursor every frame (see
+// PlatformProvider.SetCursor's docstring) doesn't re-create and
+// re-upload the same bitmap dozens of times per second.
+//
+// CursorCache is not safe for concurrent use; drive it from a single
+// UI/frame thread.
+type CursorCache struct {
+	factory CursorFactory
+	entries map[uint64]Cursor
+}
+
+// NewCursorCache creates a CursorCache that uploads cursors through
+// factory on first use.
+func NewCursorCache(factory CursorFactory) *CursorCache {
+	return &CursorCache{
+		factory: factory,
+		entries: make(map[uint64]Cursor),
+	}
+}
+
+// Get returns a Cursor for img/hotspot, creating and caching it via the
+// factory on first use and reusing the cached handle on every call with
+// the same image content and hotspot thereafter.
+func (c *CursorCache) Get(img image.Image, hotspotX, hotspotY int) (Cursor, error) {
+	key := hashCursorImage(img, hotspotX, hotspotY)
+	if cur, ok := c.entries[key]; ok {
+		return cur, nil
+	}
+
+	cur, err := c.factory.CreateCursor(img, hotspotX, hotspotY)
+	if err != nil {
+		return nil, err
+	}
+	c.entries[key] = cur
+	return cur, nil
+}
+
+// GetAnimated is the CreateAnimatedCursor equivalent of Get.
+func (c *CursorCache) GetAnimated(frames []CursorFrame) (Cursor, error) {
+	key := hashCursorFrames(frames)
+	if cur, ok := c.entries[key]; ok {
+		return cur, nil
+	}
+
+	cur, err := c.factory.CreateAnimatedCursor(frames)
+	if err != nil {
+		return nil, err
+	}
+	c.entries[key] = cur
+	return cur, nil
+}
+
+// Release releases every cached cursor and empties the cache. Call this
+// when the cache is no longer needed (e.g. on window close) to free
+// platform cursor resources.
+func (c *CursorCache) Release() {
+	for key, cur := range c.entries {
+		cur.Release()
+		delete(c.entries, key)
+	}
+}
+
+// Count returns the number of cursors currently cached.
+func (c *CursorCache) Count() int {
+	return len(c.entries)
+}
+
+// hashCursorImage computes a content hash of img's bounds and pixel data
+// plus the hotspot, so two calls with equal image content and hotspot
+// hash identically regardless of the image.Image value's identity.
+func hashCursorImage(img image.Image, hotspotX, hotspotY int) uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+
+	b := img.Bounds()
+	writeInt := func(v int) {
+		binary.BigEndian.PutUint64(buf[:], uint64(int64(v)))
+		h.Write(buf[:])
+	}
+	writeInt(b.Min.X)
+	writeInt(b.Min.Y)
+	writeInt(b.Max.X)
+	writeInt(b.Max.Y)
+	writeInt(hotspotX)
+	writeInt(hotspotY)
+
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			r, g, bl, a := img.At(x, y).RGBA()
+			binary.BigEndian.PutUint64(buf[:], uint64(r)<<48|uint64(g)<<32|uint64(bl)<<16|uint64(a))
+			h.Write(buf[:])
+		}
+	}
+
+	return h.Sum64()
+}
+
+// hashCursorFrames computes a content hash over an animated cursor's
+// frames, folding in each frame's image hash and duration in order.
+func hashCursorFrames(frames []CursorFrame) uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+
+	for _, f := range frames {
+		binary.BigEndian.PutUint64(buf[:], hashCursorImage(f.Image, 0, 0))
+		h.Write(buf[:])
+		binary.BigEndian.PutUint64(buf[:], uint64(f.Duration))
+		h.Write(buf[:])
+	}
+
+	return h.Sum64()
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import (
+	"image"
+	"image/color"
+	"testing"
+	"time"
+)
+
+// countingCursorFactory implements CursorFactory and counts how many times
+// each creation method is called, so tests can verify CursorCache actually
+// memoizes.
+type countingCursorFactory struct {
+	creates         int
+	animatedCreates int
+}
+
+func (f *countingCursorFactory) CreateCursor(image.Image, int, int) (Cursor, error) {
+	f.creates++
+	return nullCursor{}, nil
+}
+
+func (f *countingCursorFactory) CreateAnimatedCursor([]CursorFrame) (Cursor, error) {
+	f.animatedCreates++
+	return nullCursor{}, nil
+}
+
+func (f *countingCursorFactory) SetCursorHandle(Cursor) {}
+
+var _ CursorFactory = &countingCursorFactory{}
+
+func solidImage(c color.Color) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 2; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestCursorCache_Get_MemoizesByContent(t *testing.T) {
+	factory := &countingCursorFactory{}
+	cache := NewCursorCache(factory)
+
+	img := solidImage(color.White)
+
+	if _, err := cache.Get(img, 0, 0); err != nil {
+		t.Fatalf("Get() err = %v", err)
+	}
+	if _, err := cache.Get(img, 0, 0); err != nil {
+		t.Fatalf("Get() err = %v", err)
+	}
+	if factory.creates != 1 {
+		t.Errorf("factory.creates = %d, want 1 (second Get should hit cache)", factory.creates)
+	}
+	if cache.Count() != 1 {
+		t.Errorf("Count() = %d, want 1", cache.Count())
+	}
+}
+
+func TestCursorCache_Get_DifferentHotspotMisses(t *testing.T) {
+	factory := &countingCursorFactory{}
+	cache := NewCursorCache(factory)
+
+	img := solidImage(color.White)
+
+	if _, err := cache.Get(img, 0, 0); err != nil {
+		t.Fatalf("Get() err = %v", err)
+	}
+	if _, err := cache.Get(img, 1, 1); err != nil {
+		t.Fatalf("Get() err = %v", err)
+	}
+	if factory.creates != 2 {
+		t.Errorf("factory.creates = %d, want 2 (different hotspot should miss cache)", factory.creates)
+	}
+}
+
+func TestCursorCache_Get_DifferentContentMisses(t *testing.T) {
+	factory := &countingCursorFactory{}
+	cache := NewCursorCache(factory)
+
+	if _, err := cache.Get(solidImage(color.White), 0, 0); err != nil {
+		t.Fatalf("Get() err = %v", err)
+	}
+	if _, err := cache.Get(solidImage(color.Black), 0, 0); err != nil {
+		t.Fatalf("Get() err = %v", err)
+	}
+	if factory.creates != 2 {
+		t.Errorf("factory.creates = %d, want 2 (different pixel content should miss cache)", factory.creates)
+	}
+}
+
+func TestCursorCache_GetAnimated_Memoizes(t *testing.T) {
+	factory := &countingCursorFactory{}
+	cache := NewCursorCache(factory)
+
+	frames := []CursorFrame{
+		{Image: solidImage(color.White), Duration: 100 * time.Millisecond},
+		{Image: solidImage(color.Black), Duration: 100 * time.Millisecond},
+	}
+
+	if _, err := cache.GetAnimated(frames); err != nil {
+		t.Fatalf("GetAnimated() err = %v", err)
+	}
+	if _, err := cache.GetAnimated(frames); err != nil {
+		t.Fatalf("GetAnimated() err = %v", err)
+	}
+	if factory.animatedCreates != 1 {
+		t.Errorf("factory.animatedCreates = %d, want 1", factory.animatedCreates)
+	}
+}
+
+func TestCursorCache_Release(t *testing.T) {
+	factory := &countingCursorFactory{}
+	cache := NewCursorCache(factory)
+
+	if _, err := cache.Get(solidImage(color.White), 0, 0); err != nil {
+		t.Fatalf("Get() err = %v", err)
+	}
+
+	cache.Release()
+
+	if cache.Count() != 0 {
+		t.Errorf("Count() after Release() = %d, want 0", cache.Count())
+	}
+}
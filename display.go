@@ -0,0 +1,189 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "time"
+
+// DisplayID identifies a physical display (monitor) for the lifetime of
+// its connection. IDs may be reused after a display disconnects.
+type DisplayID int
+
+// Rect is an axis-aligned rectangle in physical pixels, in virtual
+// desktop coordinates (which may be negative for displays to the left of
+// or above the primary display).
+type Rect struct {
+	X, Y, Width, Height int
+}
+
+// PhysicalSize is a display's physical panel size, in millimeters, used
+// to derive true DPI independent of the OS's reported ScaleFactor.
+type PhysicalSize struct {
+	WidthMM, HeightMM float64
+}
+
+// VideoMode is one resolution/refresh-rate combination a display supports.
+type VideoMode struct {
+	Width, Height int
+	RefreshRate   float64 // Hz
+}
+
+// Display describes a single physical display (monitor).
+type Display struct {
+	// ID identifies this display among Displays().
+	ID DisplayID
+
+	// Name is the display's human-readable name, as reported by the
+	// platform (e.g. "DELL U2720Q").
+	Name string
+
+	// Bounds is the display's full area in physical pixels, in virtual
+	// desktop coordinates.
+	Bounds Rect
+
+	// WorkArea is Bounds minus space reserved by the OS for taskbars,
+	// docks, and menu bars.
+	WorkArea Rect
+
+	// PhysicalSizeMM is the display panel's physical size in millimeters.
+	// Zero if the platform cannot report it (e.g. over a remote desktop
+	// session).
+	PhysicalSizeMM PhysicalSize
+
+	// ScaleFactor is the DPI scale factor for this specific display. See
+	// WindowProvider.ScaleFactor for the single-display equivalent; this
+	// field is what lets that value change as a window is dragged
+	// between displays with different scale factors.
+	ScaleFactor float64
+
+	// RefreshRate is the display's current refresh rate in Hz.
+	RefreshRate float64
+
+	// VideoModes lists all resolution/refresh-rate combinations this
+	// display supports. Includes the mode matching Bounds/RefreshRate.
+	VideoModes []VideoMode
+}
+
+// DisplayEventKind classifies a DisplayEvent.
+type DisplayEventKind uint8
+
+const (
+	// DisplayConnected indicates a new display was attached.
+	DisplayConnected DisplayEventKind = iota
+
+	// DisplayDisconnected indicates a display was detached.
+	DisplayDisconnected
+
+	// DisplayChanged indicates an existing display's mode, work area, or
+	// scale factor changed (e.g. the user changed resolution, or the
+	// system DPI setting changed).
+	DisplayChanged
+)
+
+// String returns the event kind name for debugging.
+func (k DisplayEventKind) String() string {
+	switch k {
+	case DisplayConnected:
+		return "Connected"
+	case DisplayDisconnected:
+		return "Disconnected"
+	case DisplayChanged:
+		return "Changed"
+	default:
+		return "Unknown"
+	}
+}
+
+// DisplayEvent represents a display hot-plug or mode/DPI change.
+type DisplayEvent struct {
+	// ID identifies which display produced this event.
+	ID DisplayID
+
+	// Kind classifies this event.
+	Kind DisplayEventKind
+
+	// Display is the display's current state. For DisplayDisconnected,
+	// only ID is meaningful.
+	Display Display
+
+	// Timestamp is the event time as duration since an arbitrary
+	// reference. Zero if timestamps are not available.
+	Timestamp time.Duration
+}
+
+// DisplayProvider provides multi-monitor enumeration and per-display DPI,
+// alongside WindowProvider/PlatformProvider as another optional OS
+// integration capability.
+//
+// DisplayProvider is optional. Use type assertion to check availability:
+//
+//	if dp, ok := provider.(gpucontext.DisplayProvider); ok {
+//	    for _, d := range dp.Displays() {
+//	        fmt.Println(d.Name, d.ScaleFactor)
+//	    }
+//	}
+//
+// Platform backends (x11, wayland, win32, cocoa, wasm) register their
+// DisplayProvider implementation through DisplayProviders rather than
+// gpucontext importing them directly.
+type DisplayProvider interface {
+	// Displays returns all currently connected displays.
+	Displays() []Display
+
+	// PrimaryDisplay returns the system's designated primary display.
+	PrimaryDisplay() Display
+
+	// DisplayFor returns the display that most contains the given
+	// window, used to react to per-monitor DPI changes as a window is
+	// dragged between displays.
+	DisplayFor(wp WindowProvider) Display
+
+	// OnDisplayChange registers a callback for display hot-plug and
+	// mode/DPI-change events.
+	//
+	// Callback threading: Called on the main/UI thread.
+	// Callbacks should be fast and non-blocking.
+	OnDisplayChange(fn func(DisplayEvent))
+}
+
+// nullDisplay is the single display NullDisplayProvider reports.
+var nullDisplay = Display{
+	ID:          0,
+	Name:        "Display 0",
+	Bounds:      Rect{X: 0, Y: 0, Width: 1920, Height: 1080},
+	WorkArea:    Rect{X: 0, Y: 0, Width: 1920, Height: 1080},
+	ScaleFactor: 1.0,
+	RefreshRate: 60,
+	VideoModes:  []VideoMode{{Width: 1920, Height: 1080, RefreshRate: 60}},
+}
+
+// NullDisplayProvider implements DisplayProvider by reporting a single
+// 1920x1080@60 display and ignoring all registrations. Used for testing
+// and platforms without multi-monitor support.
+type NullDisplayProvider struct{}
+
+// Displays returns a single 1920x1080@60 display.
+func (NullDisplayProvider) Displays() []Display { return []Display{nullDisplay} }
+
+// PrimaryDisplay returns the single 1920x1080@60 display.
+func (NullDisplayProvider) PrimaryDisplay() Display { return nullDisplay }
+
+// DisplayFor returns the single 1920x1080@60 display, regardless of wp.
+func (NullDisplayProvider) DisplayFor(wp WindowProvider) Display { return nullDisplay }
+
+// OnDisplayChange does nothing.
+func (NullDisplayProvider) OnDisplayChange(func(DisplayEvent)) {}
+
+// Ensure NullDisplayProvider implements DisplayProvider.
+var _ DisplayProvider = NullDisplayProvider{}
+
+// DisplayProviders is the registry through which platform packages (x11,
+// wayland, win32, cocoa, wasm) register their DisplayProvider backend, so
+// gpucontext itself has no hard dependency on any of them.
+//
+// Example:
+//
+//	gpucontext.DisplayProviders.Register("wayland", func() gpucontext.DisplayProvider {
+//	    return waylanddisplay.New()
+//	})
+var DisplayProviders = NewRegistry[DisplayProvider]()
@@ -0,0 +1,89 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "testing"
+
+func TestDisplayEventKind_String(t *testing.T) {
+	tests := []struct {
+		kind DisplayEventKind
+		want string
+	}{
+		{DisplayConnected, "Connected"},
+		{DisplayDisconnected, "Disconnected"},
+		{DisplayChanged, "Changed"},
+		{DisplayEventKind(99), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := tt.kind.String()
+			if got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullDisplayProvider_Displays(t *testing.T) {
+	var dp DisplayProvider = NullDisplayProvider{}
+
+	displays := dp.Displays()
+	if len(displays) != 1 {
+		t.Fatalf("len(Displays()) = %d, want 1", len(displays))
+	}
+
+	d := displays[0]
+	if d.Bounds.Width != 1920 || d.Bounds.Height != 1080 {
+		t.Errorf("Bounds = %+v, want 1920x1080", d.Bounds)
+	}
+	if d.RefreshRate != 60 {
+		t.Errorf("RefreshRate = %f, want 60", d.RefreshRate)
+	}
+	if d.ScaleFactor != 1.0 {
+		t.Errorf("ScaleFactor = %f, want 1.0", d.ScaleFactor)
+	}
+	if len(d.VideoModes) != 1 {
+		t.Fatalf("len(VideoModes) = %d, want 1", len(d.VideoModes))
+	}
+}
+
+func TestNullDisplayProvider_PrimaryDisplay(t *testing.T) {
+	var dp DisplayProvider = NullDisplayProvider{}
+
+	primary := dp.PrimaryDisplay()
+	if primary.Bounds.Width != 1920 || primary.Bounds.Height != 1080 {
+		t.Errorf("PrimaryDisplay().Bounds = %+v, want 1920x1080", primary.Bounds)
+	}
+}
+
+func TestNullDisplayProvider_DisplayFor(t *testing.T) {
+	var dp DisplayProvider = NullDisplayProvider{}
+
+	d := dp.DisplayFor(NullWindowProvider{W: 800, H: 600})
+	if d.Bounds.Width != 1920 || d.Bounds.Height != 1080 {
+		t.Errorf("DisplayFor().Bounds = %+v, want 1920x1080", d.Bounds)
+	}
+}
+
+func TestNullDisplayProvider_OnDisplayChange(t *testing.T) {
+	var dp DisplayProvider = NullDisplayProvider{}
+
+	called := false
+	dp.OnDisplayChange(func(DisplayEvent) { called = true })
+	if called {
+		t.Error("NullDisplayProvider should not call the callback")
+	}
+}
+
+func TestDisplayProviders_Registry(t *testing.T) {
+	DisplayProviders.Register("test-display-backend", func() DisplayProvider {
+		return NullDisplayProvider{}
+	})
+	defer DisplayProviders.Unregister("test-display-backend")
+
+	if !DisplayProviders.Has("test-display-backend") {
+		t.Error("Has(test-display-backend) = false after registration")
+	}
+}
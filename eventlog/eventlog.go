@@ -0,0 +1,273 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+// Package eventlog records the pointer, scroll, and key events produced by
+// a real gpucontext EventSource/PointerEventSource/ScrollEventSource into a
+// replayable trace, and replays that trace deterministically against an
+// application. It is the capture-side counterpart to inputinject: where
+// inputinject synthesizes input for a test to drive, eventlog captures a
+// real (possibly unreproducible) input session - a user's bug report, a
+// flaky gesture-recognizer failure - so it can be re-run exactly later.
+package eventlog
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/gogpu/gpucontext"
+)
+
+// recordedEvent is one entry in a trace, in delivery order. Exactly one of
+// Pointer, Scroll, or Key is set, matching the callback that produced it.
+type recordedEvent struct {
+	Pointer *gpucontext.PointerEvent `json:"pointer,omitempty"`
+	Scroll  *gpucontext.ScrollEvent  `json:"scroll,omitempty"`
+	Key     *gpucontext.KeyEvent     `json:"key,omitempty"`
+}
+
+// timestamp returns ev's event timestamp, or zero for a Key event, which
+// carries no Timestamp field.
+func (ev recordedEvent) timestamp() time.Duration {
+	switch {
+	case ev.Pointer != nil:
+		return ev.Pointer.Timestamp
+	case ev.Scroll != nil:
+		return ev.Scroll.Timestamp
+	default:
+		return 0
+	}
+}
+
+// Recorder taps a platform source and records every pointer, scroll, and
+// key event it emits into a replayable trace, while still forwarding each
+// event to whatever callback the application registers on the Recorder
+// itself - recording is completely transparent to the rest of the app.
+//
+// Recorder implements EventSource, PointerEventSource, and
+// ScrollEventSource, delegating every method it doesn't itself record to
+// the gpucontext Null* implementations, so it can be used in the wrapped
+// source's place without any other code change.
+//
+// Construct with NewRecorder. Not safe for concurrent use.
+type Recorder struct {
+	gpucontext.NullEventSource
+	gpucontext.NullPointerEventSource
+	gpucontext.NullScrollEventSource
+
+	trace []recordedEvent
+
+	onPointer func(gpucontext.PointerEvent)
+	onScroll  func(gpucontext.ScrollEvent)
+	onKey     func(gpucontext.KeyEvent)
+}
+
+// NewRecorder wires itself in front of src, recording every event produced
+// by whichever of PointerEventSource, ScrollEventSource, and EventSource
+// src implements. It returns the Recorder (for Len/Save) and the same
+// Recorder as a Source; pass the second result to the rest of the
+// application in place of src.
+func NewRecorder(src any) (*Recorder, *Recorder) {
+	r := &Recorder{}
+	if ps, ok := src.(gpucontext.PointerEventSource); ok {
+		ps.OnPointer(r.recordPointer)
+	}
+	if ss, ok := src.(gpucontext.ScrollEventSource); ok {
+		ss.OnScrollEvent(r.recordScroll)
+	}
+	if es, ok := src.(gpucontext.EventSource); ok {
+		es.OnKey(r.recordKey)
+	}
+	return r, r
+}
+
+func (r *Recorder) recordPointer(ev gpucontext.PointerEvent) {
+	r.trace = append(r.trace, recordedEvent{Pointer: &ev})
+	if r.onPointer != nil {
+		r.onPointer(ev)
+	}
+}
+
+func (r *Recorder) recordScroll(ev gpucontext.ScrollEvent) {
+	r.trace = append(r.trace, recordedEvent{Scroll: &ev})
+	if r.onScroll != nil {
+		r.onScroll(ev)
+	}
+}
+
+func (r *Recorder) recordKey(ev gpucontext.KeyEvent) {
+	r.trace = append(r.trace, recordedEvent{Key: &ev})
+	if r.onKey != nil {
+		r.onKey(ev)
+	}
+}
+
+// OnPointer registers fn to receive every recorded pointer event,
+// overriding the embedded NullPointerEventSource's no-op OnPointer.
+func (r *Recorder) OnPointer(fn func(gpucontext.PointerEvent)) { r.onPointer = fn }
+
+// OnScrollEvent registers fn to receive every recorded scroll event,
+// overriding the embedded NullScrollEventSource's no-op OnScrollEvent.
+func (r *Recorder) OnScrollEvent(fn func(gpucontext.ScrollEvent)) { r.onScroll = fn }
+
+// OnKey registers fn to receive every recorded key event, overriding the
+// embedded NullEventSource's no-op OnKey.
+func (r *Recorder) OnKey(fn func(gpucontext.KeyEvent)) { r.onKey = fn }
+
+// Len returns the number of events recorded so far.
+func (r *Recorder) Len() int { return len(r.trace) }
+
+// Save writes the recorded trace to w as JSON, for replay with NewReplayer
+// or comparison with Diff.
+func (r *Recorder) Save(w io.Writer) error {
+	return json.NewEncoder(w).Encode(r.trace)
+}
+
+// Ensure Recorder implements EventSource, PointerEventSource, and
+// ScrollEventSource.
+var _ gpucontext.EventSource = (*Recorder)(nil)
+var _ gpucontext.PointerEventSource = (*Recorder)(nil)
+var _ gpucontext.ScrollEventSource = (*Recorder)(nil)
+
+// ReplaySource implements EventSource, PointerEventSource, and
+// ScrollEventSource, replaying a trace previously written by
+// Recorder.Save instead of driving from a real platform backend. Use it
+// to re-run a captured input session - a bug report, or a regression test
+// for a gesture recognizer - deterministically against an application.
+//
+// Construct with NewReplayer.
+type ReplaySource struct {
+	gpucontext.NullEventSource
+	gpucontext.NullPointerEventSource
+	gpucontext.NullScrollEventSource
+
+	trace []recordedEvent
+
+	onPointer func(gpucontext.PointerEvent)
+	onScroll  func(gpucontext.ScrollEvent)
+	onKey     func(gpucontext.KeyEvent)
+}
+
+// NewReplayer reads a trace previously written by Recorder.Save.
+func NewReplayer(r io.Reader) (*ReplaySource, error) {
+	var trace []recordedEvent
+	if err := json.NewDecoder(r).Decode(&trace); err != nil {
+		return nil, err
+	}
+	return &ReplaySource{trace: trace}, nil
+}
+
+// OnPointer registers fn to receive replayed pointer events, overriding
+// the embedded NullPointerEventSource's no-op OnPointer.
+func (s *ReplaySource) OnPointer(fn func(gpucontext.PointerEvent)) { s.onPointer = fn }
+
+// OnScrollEvent registers fn to receive replayed scroll events, overriding
+// the embedded NullScrollEventSource's no-op OnScrollEvent.
+func (s *ReplaySource) OnScrollEvent(fn func(gpucontext.ScrollEvent)) { s.onScroll = fn }
+
+// OnKey registers fn to receive replayed key events, overriding the
+// embedded NullEventSource's no-op OnKey.
+func (s *ReplaySource) OnKey(fn func(gpucontext.KeyEvent)) { s.onKey = fn }
+
+// Len returns the number of events in the trace.
+func (s *ReplaySource) Len() int { return len(s.trace) }
+
+// Replay delivers every event in the trace, in order, to the registered
+// callbacks as fast as possible, ignoring each event's Timestamp. Use
+// ReplayRealTime to preserve the recording's original timing.
+func (s *ReplaySource) Replay() {
+	for _, ev := range s.trace {
+		s.deliver(ev)
+	}
+}
+
+// ReplayRealTime delivers every event in the trace, in order, sleeping
+// between events so their relative timing matches the recording, scaled
+// by speed (2 replays twice as fast, 0.5 replays at half speed). speed <= 0
+// is treated as 1.
+func (s *ReplaySource) ReplayRealTime(speed float64) {
+	if speed <= 0 {
+		speed = 1
+	}
+
+	var last time.Duration
+	for i, ev := range s.trace {
+		ts := ev.timestamp()
+		if i > 0 {
+			if gap := ts - last; gap > 0 {
+				time.Sleep(time.Duration(float64(gap) / speed))
+			}
+		}
+		last = ts
+		s.deliver(ev)
+	}
+}
+
+func (s *ReplaySource) deliver(ev recordedEvent) {
+	switch {
+	case ev.Pointer != nil && s.onPointer != nil:
+		s.onPointer(*ev.Pointer)
+	case ev.Scroll != nil && s.onScroll != nil:
+		s.onScroll(*ev.Scroll)
+	case ev.Key != nil && s.onKey != nil:
+		s.onKey(*ev.Key)
+	}
+}
+
+// Ensure ReplaySource implements EventSource, PointerEventSource, and
+// ScrollEventSource.
+var _ gpucontext.EventSource = (*ReplaySource)(nil)
+var _ gpucontext.PointerEventSource = (*ReplaySource)(nil)
+var _ gpucontext.ScrollEventSource = (*ReplaySource)(nil)
+
+// DiffResult describes how two recordings compare.
+type DiffResult struct {
+	// Equal is true if both recordings contain the same events in the
+	// same order.
+	Equal bool
+
+	// Index is the position of the first differing or missing event, or
+	// -1 if Equal.
+	Index int
+
+	// LenA and LenB are the two recordings' event counts.
+	LenA, LenB int
+}
+
+// Diff compares two recordings previously written by Recorder.Save, for
+// regression testing a gesture recognizer against a captured session: feed
+// a golden recording through the recognizer, save its output, and Diff it
+// against a new run's output to catch behavior changes.
+func Diff(a, b io.Reader) (DiffResult, error) {
+	ta, err := readTrace(a)
+	if err != nil {
+		return DiffResult{}, err
+	}
+	tb, err := readTrace(b)
+	if err != nil {
+		return DiffResult{}, err
+	}
+
+	n := len(ta)
+	if len(tb) < n {
+		n = len(tb)
+	}
+	for i := 0; i < n; i++ {
+		if !reflect.DeepEqual(ta[i], tb[i]) {
+			return DiffResult{Index: i, LenA: len(ta), LenB: len(tb)}, nil
+		}
+	}
+	if len(ta) != len(tb) {
+		return DiffResult{Index: n, LenA: len(ta), LenB: len(tb)}, nil
+	}
+	return DiffResult{Equal: true, Index: -1, LenA: len(ta), LenB: len(tb)}, nil
+}
+
+func readTrace(r io.Reader) ([]recordedEvent, error) {
+	var trace []recordedEvent
+	if err := json.NewDecoder(r).Decode(&trace); err != nil {
+		return nil, err
+	}
+	return trace, nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package eventlog
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gogpu/gpucontext"
+)
+
+// fakeSource is a minimal EventSource/PointerEventSource/ScrollEventSource
+// for driving Recorder in tests, standing in for a real platform backend.
+type fakeSource struct {
+	gpucontext.NullEventSource
+	gpucontext.NullPointerEventSource
+	gpucontext.NullScrollEventSource
+
+	onPointer func(gpucontext.PointerEvent)
+	onScroll  func(gpucontext.ScrollEvent)
+	onKey     func(gpucontext.KeyEvent)
+}
+
+func (s *fakeSource) OnPointer(fn func(gpucontext.PointerEvent))    { s.onPointer = fn }
+func (s *fakeSource) OnScrollEvent(fn func(gpucontext.ScrollEvent)) { s.onScroll = fn }
+func (s *fakeSource) OnKey(fn func(gpucontext.KeyEvent))            { s.onKey = fn }
+
+var _ gpucontext.EventSource = (*fakeSource)(nil)
+var _ gpucontext.PointerEventSource = (*fakeSource)(nil)
+var _ gpucontext.ScrollEventSource = (*fakeSource)(nil)
+
+func TestRecorder_RecordsAndForwards(t *testing.T) {
+	src := &fakeSource{}
+	rec, wrapped := NewRecorder(src)
+
+	var delivered []gpucontext.PointerKind
+	wrapped.OnPointer(func(ev gpucontext.PointerEvent) { delivered = append(delivered, ev.Kind) })
+
+	src.onPointer(gpucontext.PointerEvent{Kind: gpucontext.PointerDown, Timestamp: time.Millisecond})
+	src.onPointer(gpucontext.PointerEvent{Kind: gpucontext.PointerUp, Timestamp: 2 * time.Millisecond})
+	src.onScroll(gpucontext.ScrollEvent{DeltaY: 3, Timestamp: 3 * time.Millisecond})
+
+	if rec.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", rec.Len())
+	}
+	if len(delivered) != 2 || delivered[0] != gpucontext.PointerDown || delivered[1] != gpucontext.PointerUp {
+		t.Errorf("delivered = %v, want [Down Up] (forwarded to wrapped callback)", delivered)
+	}
+}
+
+func TestRecorder_SaveAndReplay(t *testing.T) {
+	src := &fakeSource{}
+	rec, _ := NewRecorder(src)
+
+	src.onPointer(gpucontext.PointerEvent{Kind: gpucontext.PointerDown, X: 1, Timestamp: 0})
+	src.onScroll(gpucontext.ScrollEvent{DeltaY: 5, Timestamp: time.Millisecond})
+	src.onPointer(gpucontext.PointerEvent{Kind: gpucontext.PointerUp, X: 1, Timestamp: 2 * time.Millisecond})
+
+	var buf bytes.Buffer
+	if err := rec.Save(&buf); err != nil {
+		t.Fatalf("Save() err = %v", err)
+	}
+
+	replay, err := NewReplayer(&buf)
+	if err != nil {
+		t.Fatalf("NewReplayer() err = %v", err)
+	}
+	if replay.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", replay.Len())
+	}
+
+	var kinds []gpucontext.PointerKind
+	var scrolls int
+	replay.OnPointer(func(ev gpucontext.PointerEvent) { kinds = append(kinds, ev.Kind) })
+	replay.OnScrollEvent(func(gpucontext.ScrollEvent) { scrolls++ })
+
+	replay.Replay()
+
+	if len(kinds) != 2 || kinds[0] != gpucontext.PointerDown || kinds[1] != gpucontext.PointerUp {
+		t.Errorf("kinds = %v, want [Down Up]", kinds)
+	}
+	if scrolls != 1 {
+		t.Errorf("scrolls = %d, want 1", scrolls)
+	}
+}
+
+func TestReplaySource_ReplayRealTime(t *testing.T) {
+	src := &fakeSource{}
+	rec, _ := NewRecorder(src)
+
+	src.onPointer(gpucontext.PointerEvent{Kind: gpucontext.PointerDown, Timestamp: 0})
+	src.onPointer(gpucontext.PointerEvent{Kind: gpucontext.PointerUp, Timestamp: 5 * time.Millisecond})
+
+	var buf bytes.Buffer
+	rec.Save(&buf)
+
+	replay, err := NewReplayer(&buf)
+	if err != nil {
+		t.Fatalf("NewReplayer() err = %v", err)
+	}
+
+	var count int
+	replay.OnPointer(func(gpucontext.PointerEvent) { count++ })
+
+	start := time.Now()
+	replay.ReplayRealTime(1000) // fast enough to keep the test quick
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("ReplayRealTime took %v, want well under 1s at 1000x speed", elapsed)
+	}
+	if count != 2 {
+		t.Errorf("count = %d, want 2", count)
+	}
+}
+
+func TestDiff_EqualRecordings(t *testing.T) {
+	src := &fakeSource{}
+	rec, _ := NewRecorder(src)
+	src.onPointer(gpucontext.PointerEvent{Kind: gpucontext.PointerDown})
+
+	var a, b bytes.Buffer
+	rec.Save(&a)
+	rec.Save(&b)
+
+	result, err := Diff(&a, &b)
+	if err != nil {
+		t.Fatalf("Diff() err = %v", err)
+	}
+	if !result.Equal || result.Index != -1 {
+		t.Errorf("result = %+v, want Equal with Index -1", result)
+	}
+}
+
+func TestDiff_DivergingRecordings(t *testing.T) {
+	srcA := &fakeSource{}
+	recA, _ := NewRecorder(srcA)
+	srcA.onPointer(gpucontext.PointerEvent{Kind: gpucontext.PointerDown, X: 1})
+
+	srcB := &fakeSource{}
+	recB, _ := NewRecorder(srcB)
+	srcB.onPointer(gpucontext.PointerEvent{Kind: gpucontext.PointerDown, X: 2})
+
+	var a, b bytes.Buffer
+	recA.Save(&a)
+	recB.Save(&b)
+
+	result, err := Diff(&a, &b)
+	if err != nil {
+		t.Fatalf("Diff() err = %v", err)
+	}
+	if result.Equal || result.Index != 0 {
+		t.Errorf("result = %+v, want Equal=false Index=0", result)
+	}
+}
+
+func TestDiff_DifferentLengths(t *testing.T) {
+	srcA := &fakeSource{}
+	recA, _ := NewRecorder(srcA)
+	srcA.onPointer(gpucontext.PointerEvent{Kind: gpucontext.PointerDown})
+
+	srcB := &fakeSource{}
+	recB, _ := NewRecorder(srcB)
+	srcB.onPointer(gpucontext.PointerEvent{Kind: gpucontext.PointerDown})
+	srcB.onPointer(gpucontext.PointerEvent{Kind: gpucontext.PointerUp})
+
+	var a, b bytes.Buffer
+	recA.Save(&a)
+	recB.Save(&b)
+
+	result, err := Diff(&a, &b)
+	if err != nil {
+		t.Fatalf("Diff() err = %v", err)
+	}
+	if result.Equal || result.Index != 1 || result.LenA != 1 || result.LenB != 2 {
+		t.Errorf("result = %+v, want Equal=false Index=1 LenA=1 LenB=2", result)
+	}
+}
@@ -33,11 +33,28 @@ type EventSource interface {
 	// Keyboard events
 
 	// OnKeyPress registers a callback for key press events.
+	//
+	// Deprecated: prefer OnKey, which distinguishes physical and logical
+	// key, reports the produced text, and flags auto-repeat. OnKeyPress
+	// is kept for backends and consumers that only need the basic code.
 	OnKeyPress(func(key Key, mods Modifiers))
 
 	// OnKeyRelease registers a callback for key release events.
+	//
+	// Deprecated: prefer OnKey, filtering on KeyEvent.Pressed == false;
+	// kept for backward compatibility.
 	OnKeyRelease(func(key Key, mods Modifiers))
 
+	// OnKey registers a callback for richer key events, modelled on the
+	// W3C UI Events / winit keyboard model. Unlike OnKeyPress/OnKeyRelease,
+	// KeyEvent distinguishes the physical key from the logical key produced
+	// by the active keyboard layout, reports the text the key produced (if
+	// any), and flags OS-level auto-repeat.
+	//
+	// Backends that only expose legacy (Key, Modifiers) data should
+	// synthesize a KeyEvent with SynthesizeKeyEvent so OnKey still fires.
+	OnKey(fn func(KeyEvent))
+
 	// OnTextInput registers a callback for text input events.
 	// Text input is the result of key presses after applying keyboard layouts
 	// and input methods. This is the preferred way to handle text entry.
@@ -248,8 +265,128 @@ const (
 	KeyPause
 )
 
+// KeyLocation distinguishes keys that share a logical meaning but occupy
+// different physical positions on the keyboard (e.g. left vs. right Shift,
+// or a numpad digit vs. the corresponding main-row digit).
+type KeyLocation uint8
+
+const (
+	// KeyLocationStandard is the default location for keys that only
+	// occupy a single position on the keyboard.
+	KeyLocationStandard KeyLocation = iota
+
+	// KeyLocationLeft marks the left-hand variant of a duplicated key
+	// (e.g. KeyLeftShift, KeyLeftControl).
+	KeyLocationLeft
+
+	// KeyLocationRight marks the right-hand variant of a duplicated key
+	// (e.g. KeyRightShift, KeyRightControl).
+	KeyLocationRight
+
+	// KeyLocationNumpad marks a key on the numeric keypad.
+	KeyLocationNumpad
+)
+
+// String returns the key location name for debugging.
+func (l KeyLocation) String() string {
+	switch l {
+	case KeyLocationStandard:
+		return "Standard"
+	case KeyLocationLeft:
+		return "Left"
+	case KeyLocationRight:
+		return "Right"
+	case KeyLocationNumpad:
+		return "Numpad"
+	default:
+		return "Unknown"
+	}
+}
+
+// Location returns the KeyLocation implied by a legacy Key constant.
+// This lets backends that only know the old left/right-specific codes
+// (KeyLeftShift, KeyNumpad0, ...) derive a Location for KeyEvent without
+// maintaining a second lookup table.
+func (k Key) Location() KeyLocation {
+	switch k {
+	case KeyLeftShift, KeyLeftControl, KeyLeftAlt, KeyLeftSuper:
+		return KeyLocationLeft
+	case KeyRightShift, KeyRightControl, KeyRightAlt, KeyRightSuper:
+		return KeyLocationRight
+	case KeyNumpad0, KeyNumpad1, KeyNumpad2, KeyNumpad3, KeyNumpad4,
+		KeyNumpad5, KeyNumpad6, KeyNumpad7, KeyNumpad8, KeyNumpad9,
+		KeyNumpadDecimal, KeyNumpadDivide, KeyNumpadMultiply,
+		KeyNumpadSubtract, KeyNumpadAdd, KeyNumpadEnter:
+		return KeyLocationNumpad
+	default:
+		return KeyLocationStandard
+	}
+}
+
+// KeyEvent is a richer key event modelled on the W3C UI Events / winit
+// keyboard model. It distinguishes the physical key from the key produced
+// after applying the active keyboard layout, reports the text the key
+// event produced (after layout and IME processing), and flags whether the
+// event was synthesized by OS-level auto-repeat.
+//
+// Example usage:
+//
+//	source.OnKey(func(ev gpucontext.KeyEvent) {
+//	    if ev.LogicalKey == gpucontext.KeySlash && ev.Modifiers.HasShift() {
+//	        // "?" on a US layout, regardless of which physical key it maps to
+//	        showHelp()
+//	    }
+//	})
+type KeyEvent struct {
+	// PhysicalKey is the layout-independent key that was pressed or
+	// released, identified by its physical position on the keyboard
+	// (USB HID-style). This is what Key meant before KeyEvent existed.
+	PhysicalKey Key
+
+	// LogicalKey is PhysicalKey after applying the active keyboard layout.
+	// For example, the physical KeySlash key produces a logical "question"
+	// token when Shift is held on a US layout.
+	LogicalKey Key
+
+	// Text is the grapheme(s) this single key event produced, after
+	// keyboard-layout and IME processing. Empty for non-text keys
+	// (modifiers, function keys, arrows, ...).
+	Text string
+
+	// Location distinguishes left/right/numpad variants of a key that
+	// would otherwise share the same logical meaning.
+	Location KeyLocation
+
+	// Repeat is true when this event was synthesized by OS-level
+	// auto-repeat rather than a fresh physical press.
+	Repeat bool
+
+	// Pressed indicates whether this is a key-down (true) or key-up
+	// (false) event.
+	Pressed bool
+
+	// Modifiers contains the keyboard modifier state at event time.
+	Modifiers Modifiers
+}
+
+// SynthesizeKeyEvent builds a KeyEvent from the legacy (Key, Modifiers)
+// callback signature, for backends that cannot yet distinguish physical
+// from logical key, produced text, or auto-repeat. PhysicalKey and
+// LogicalKey are both set to key, Location is derived via Key.Location,
+// and Text is left empty since legacy backends don't report it.
+func SynthesizeKeyEvent(key Key, mods Modifiers, pressed, repeat bool) KeyEvent {
+	return KeyEvent{
+		PhysicalKey: key,
+		LogicalKey:  key,
+		Location:    key.Location(),
+		Repeat:      repeat,
+		Pressed:     pressed,
+		Modifiers:   mods,
+	}
+}
+
 // Modifiers represents keyboard modifier keys.
-type Modifiers uint8
+type Modifiers uint16
 
 const (
 	// ModShift indicates the Shift key is pressed.
@@ -269,6 +406,31 @@ const (
 
 	// ModNumLock indicates Num Lock is active.
 	ModNumLock
+
+	// ModLeftShift indicates the left Shift key specifically is pressed.
+	// Backends that can distinguish sides set this in addition to ModShift.
+	ModLeftShift
+
+	// ModRightShift indicates the right Shift key specifically is pressed.
+	ModRightShift
+
+	// ModLeftControl indicates the left Control key specifically is pressed.
+	ModLeftControl
+
+	// ModRightControl indicates the right Control key specifically is pressed.
+	ModRightControl
+
+	// ModLeftAlt indicates the left Alt key specifically is pressed.
+	ModLeftAlt
+
+	// ModRightAlt indicates the right Alt key specifically is pressed.
+	ModRightAlt
+
+	// ModLeftSuper indicates the left Super key specifically is pressed.
+	ModLeftSuper
+
+	// ModRightSuper indicates the right Super key specifically is pressed.
+	ModRightSuper
 )
 
 // HasShift returns true if the Shift modifier is set.
@@ -291,6 +453,47 @@ func (m Modifiers) HasSuper() bool {
 	return m&ModSuper != 0
 }
 
+// HasLeftShift returns true if the left Shift key specifically is pressed.
+// Backends that cannot distinguish sides never set this bit.
+func (m Modifiers) HasLeftShift() bool {
+	return m&ModLeftShift != 0
+}
+
+// HasRightShift returns true if the right Shift key specifically is pressed.
+func (m Modifiers) HasRightShift() bool {
+	return m&ModRightShift != 0
+}
+
+// HasLeftControl returns true if the left Control key specifically is pressed.
+func (m Modifiers) HasLeftControl() bool {
+	return m&ModLeftControl != 0
+}
+
+// HasRightControl returns true if the right Control key specifically is pressed.
+func (m Modifiers) HasRightControl() bool {
+	return m&ModRightControl != 0
+}
+
+// HasLeftAlt returns true if the left Alt key specifically is pressed.
+func (m Modifiers) HasLeftAlt() bool {
+	return m&ModLeftAlt != 0
+}
+
+// HasRightAlt returns true if the right Alt key specifically is pressed.
+func (m Modifiers) HasRightAlt() bool {
+	return m&ModRightAlt != 0
+}
+
+// HasLeftSuper returns true if the left Super key specifically is pressed.
+func (m Modifiers) HasLeftSuper() bool {
+	return m&ModLeftSuper != 0
+}
+
+// HasRightSuper returns true if the right Super key specifically is pressed.
+func (m Modifiers) HasRightSuper() bool {
+	return m&ModRightSuper != 0
+}
+
 // MouseButton represents a mouse button.
 type MouseButton uint8
 
@@ -321,6 +524,9 @@ func (NullEventSource) OnKeyPress(func(Key, Modifiers)) {}
 // OnKeyRelease does nothing.
 func (NullEventSource) OnKeyRelease(func(Key, Modifiers)) {}
 
+// OnKey does nothing.
+func (NullEventSource) OnKey(func(KeyEvent)) {}
+
 // OnTextInput does nothing.
 func (NullEventSource) OnTextInput(func(string)) {}
 
@@ -0,0 +1,283 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import (
+	"context"
+	"sync"
+)
+
+// Event is implemented by every concrete event type delivered through the
+// channel-based Events/PumpContext API. It exists purely as a marker so the
+// compiler can enforce that only known event types flow through the
+// channel; it carries no behavior of its own.
+//
+// This is an idiomatic Go alternative to registering many callback
+// closures on EventSource, modelled on the channel-based event stream in
+// aqwari.net/exp/display: consumers can `select` on input alongside other
+// channels (timers, done signals, application channels) instead of
+// threading state through N callbacks.
+//
+// Concrete types: KeyEvent, TextInputEvent, MouseMoveEvent, MousePressEvent,
+// MouseReleaseEvent, ScrollEvent, ResizeEvent, FocusEvent,
+// IMECompositionStartEvent, IMECompositionUpdateEvent, IMECompositionEndEvent.
+type Event interface {
+	isEvent()
+}
+
+func (KeyEvent) isEvent()    {}
+func (ScrollEvent) isEvent() {}
+
+// TextInputEvent is delivered for EventSource.OnTextInput.
+type TextInputEvent struct {
+	// Text is the committed text, after keyboard layout and IME processing.
+	Text string
+}
+
+func (TextInputEvent) isEvent() {}
+
+// MouseMoveEvent is delivered for EventSource.OnMouseMove.
+type MouseMoveEvent struct {
+	X, Y float64
+}
+
+func (MouseMoveEvent) isEvent() {}
+
+// MousePressEvent is delivered for EventSource.OnMousePress.
+type MousePressEvent struct {
+	Button MouseButton
+	X, Y   float64
+}
+
+func (MousePressEvent) isEvent() {}
+
+// MouseReleaseEvent is delivered for EventSource.OnMouseRelease.
+type MouseReleaseEvent struct {
+	Button MouseButton
+	X, Y   float64
+}
+
+func (MouseReleaseEvent) isEvent() {}
+
+// ResizeEvent is delivered for EventSource.OnResize.
+type ResizeEvent struct {
+	Width, Height int
+}
+
+func (ResizeEvent) isEvent() {}
+
+// FocusEvent is delivered for EventSource.OnFocus.
+type FocusEvent struct {
+	Focused bool
+}
+
+func (FocusEvent) isEvent() {}
+
+// IMECompositionStartEvent is delivered for EventSource.OnIMECompositionStart.
+type IMECompositionStartEvent struct{}
+
+func (IMECompositionStartEvent) isEvent() {}
+
+// IMECompositionUpdateEvent is delivered for EventSource.OnIMECompositionUpdate.
+type IMECompositionUpdateEvent struct {
+	State IMEState
+}
+
+func (IMECompositionUpdateEvent) isEvent() {}
+
+// IMECompositionEndEvent is delivered for EventSource.OnIMECompositionEnd.
+type IMECompositionEndEvent struct {
+	Committed string
+}
+
+func (IMECompositionEndEvent) isEvent() {}
+
+// Events registers every On* callback on src once and fans them into a
+// single buffered channel of Event, so consumers can `select` on input
+// rather than juggling many callback closures.
+//
+// buf is the channel buffer size; values <= 0 are treated as 1.
+//
+// Backpressure policy: if the channel is full, MouseMoveEvent and
+// ResizeEvent are coalesced (the newest value wins and is delivered as
+// soon as room is available) since only the latest position/size usually
+// matters. Every other event kind is dropped rather than blocking the
+// platform event thread, since callbacks must stay fast and non-blocking.
+//
+// Events returns the channel and a cancel function that stops the
+// coalescing goroutines. EventSource has no way to unregister a callback,
+// so cancel cannot stop new events from being generated; it only stops
+// forwarding them and abandons the channel so it can be garbage collected
+// once the caller drops its reference. The channel is deliberately never
+// closed, since a send racing with cancel must never panic. Calling
+// cancel more than once is safe.
+//
+// Example:
+//
+//	events, cancel := gpucontext.Events(source, 64)
+//	defer cancel()
+//	for ev := range events {
+//	    switch e := ev.(type) {
+//	    case gpucontext.MouseMoveEvent:
+//	        hover(e.X, e.Y)
+//	    case gpucontext.KeyEvent:
+//	        handleKey(e)
+//	    }
+//	}
+func Events(src EventSource, buf int) (<-chan Event, func()) {
+	if buf <= 0 {
+		buf = 1
+	}
+
+	ch := make(chan Event, buf)
+	done := make(chan struct{})
+
+	move := newEventCoalescer(ch, done)
+	resize := newEventCoalescer(ch, done)
+
+	send := func(ev Event) {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+
+	src.OnKeyPress(func(key Key, mods Modifiers) {
+		send(SynthesizeKeyEvent(key, mods, true, false))
+	})
+	src.OnKeyRelease(func(key Key, mods Modifiers) {
+		send(SynthesizeKeyEvent(key, mods, false, false))
+	})
+	src.OnKey(func(ev KeyEvent) { send(ev) })
+	src.OnTextInput(func(text string) { send(TextInputEvent{Text: text}) })
+	src.OnMouseMove(func(x, y float64) {
+		ev := MouseMoveEvent{X: x, Y: y}
+		select {
+		case ch <- ev:
+		default:
+			move.set(ev)
+		}
+	})
+	src.OnMousePress(func(button MouseButton, x, y float64) {
+		send(MousePressEvent{Button: button, X: x, Y: y})
+	})
+	src.OnMouseRelease(func(button MouseButton, x, y float64) {
+		send(MouseReleaseEvent{Button: button, X: x, Y: y})
+	})
+	src.OnResize(func(width, height int) {
+		ev := ResizeEvent{Width: width, Height: height}
+		select {
+		case ch <- ev:
+		default:
+			resize.set(ev)
+		}
+	})
+	src.OnFocus(func(focused bool) { send(FocusEvent{Focused: focused}) })
+	src.OnIMECompositionStart(func() { send(IMECompositionStartEvent{}) })
+	src.OnIMECompositionUpdate(func(state IMEState) {
+		send(IMECompositionUpdateEvent{State: state})
+	})
+	src.OnIMECompositionEnd(func(committed string) {
+		send(IMECompositionEndEvent{Committed: committed})
+	})
+
+	if ses, ok := src.(ScrollEventSource); ok {
+		ses.OnScrollEvent(func(ev ScrollEvent) { send(ev) })
+	} else {
+		src.OnScroll(func(dx, dy float64) { send(ScrollEvent{DeltaX: dx, DeltaY: dy}) })
+	}
+
+	var closeOnce sync.Once
+	cancel := func() {
+		closeOnce.Do(func() {
+			close(done)
+		})
+	}
+	return ch, cancel
+}
+
+// PumpContext calls handler for every event delivered by src until ctx is
+// cancelled, at which point it stops cleanly and returns. It is a
+// convenience wrapper around Events for callers that want a blocking loop
+// rather than managing the channel and cancel function themselves.
+func PumpContext(ctx context.Context, src EventSource, handler func(Event)) {
+	events, cancel := Events(src, 64)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			handler(ev)
+		}
+	}
+}
+
+// eventCoalescer delivers only the most recently set event of a given
+// kind, dropping any value that gets replaced before it can be sent. It is
+// used to implement the "coalesce when full" policy for high-frequency
+// events like MouseMoveEvent and ResizeEvent.
+type eventCoalescer struct {
+	mu      sync.Mutex
+	pending Event
+	has     bool
+	wake    chan struct{}
+}
+
+func newEventCoalescer(ch chan Event, done chan struct{}) *eventCoalescer {
+	c := &eventCoalescer{wake: make(chan struct{}, 1)}
+	go c.run(ch, done)
+	return c
+}
+
+func (c *eventCoalescer) set(ev Event) {
+	c.mu.Lock()
+	c.pending = ev
+	c.has = true
+	c.mu.Unlock()
+
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (c *eventCoalescer) run(ch chan<- Event, done <-chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		case <-c.wake:
+		}
+
+		c.mu.Lock()
+		ev, has := c.pending, c.has
+		c.has = false
+		c.mu.Unlock()
+
+		if !has {
+			continue
+		}
+
+	send:
+		for {
+			select {
+			case ch <- ev:
+				break send
+			case <-c.wake:
+				c.mu.Lock()
+				if c.has {
+					ev, c.has = c.pending, false
+				}
+				c.mu.Unlock()
+			case <-done:
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// fakeEventSource is a minimal EventSource that lets tests fire specific
+// callbacks synchronously.
+type fakeEventSource struct {
+	NullEventSource
+	onKeyPress     func(Key, Modifiers)
+	onKey          func(KeyEvent)
+	onTextInput    func(string)
+	onMouseMove    func(float64, float64)
+	onMousePress   func(MouseButton, float64, float64)
+	onMouseRelease func(MouseButton, float64, float64)
+	onResize       func(int, int)
+	onFocus        func(bool)
+}
+
+func (f *fakeEventSource) OnKeyPress(fn func(Key, Modifiers))    { f.onKeyPress = fn }
+func (f *fakeEventSource) OnKey(fn func(KeyEvent))               { f.onKey = fn }
+func (f *fakeEventSource) OnTextInput(fn func(string))           { f.onTextInput = fn }
+func (f *fakeEventSource) OnMouseMove(fn func(float64, float64)) { f.onMouseMove = fn }
+
+func (f *fakeEventSource) OnMousePress(fn func(MouseButton, float64, float64)) {
+	f.onMousePress = fn
+}
+
+func (f *fakeEventSource) OnMouseRelease(fn func(MouseButton, float64, float64)) {
+	f.onMouseRelease = fn
+}
+
+func (f *fakeEventSource) OnResize(fn func(int, int)) { f.onResize = fn }
+func (f *fakeEventSource) OnFocus(fn func(bool))      { f.onFocus = fn }
+
+func TestEvents_DeliversTypedEvents(t *testing.T) {
+	src := &fakeEventSource{}
+	events, cancel := Events(src, 8)
+	defer cancel()
+
+	src.onKey(KeyEvent{PhysicalKey: KeyA, LogicalKey: KeyA})
+	src.onTextInput("hi")
+	src.onMousePress(MouseButtonLeft, 1, 2)
+	src.onFocus(true)
+
+	want := []Event{
+		KeyEvent{PhysicalKey: KeyA, LogicalKey: KeyA},
+		TextInputEvent{Text: "hi"},
+		MousePressEvent{Button: MouseButtonLeft, X: 1, Y: 2},
+		FocusEvent{Focused: true},
+	}
+
+	for i, w := range want {
+		select {
+		case got := <-events:
+			if got != w {
+				t.Errorf("event %d: got %#v, want %#v", i, got, w)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("event %d: timed out waiting for delivery", i)
+		}
+	}
+}
+
+func TestEvents_LegacyKeyPressSynthesizesKeyEvent(t *testing.T) {
+	src := &fakeEventSource{}
+	events, cancel := Events(src, 8)
+	defer cancel()
+
+	src.onKeyPress(KeyEnter, ModShift)
+
+	select {
+	case got := <-events:
+		ev, ok := got.(KeyEvent)
+		if !ok {
+			t.Fatalf("got %T, want KeyEvent", got)
+		}
+		if ev.PhysicalKey != KeyEnter || ev.Modifiers != ModShift || !ev.Pressed {
+			t.Errorf("KeyEvent = %+v, want PhysicalKey=KeyEnter Modifiers=ModShift Pressed=true", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for synthesized KeyEvent")
+	}
+}
+
+func TestEvents_CoalescesMouseMoveWhenFull(t *testing.T) {
+	src := &fakeEventSource{}
+	// Unbuffered effectively: buffer of 1, and we never drain it, so the
+	// second and third moves must be coalesced rather than dropped outright.
+	events, cancel := Events(src, 1)
+	defer cancel()
+
+	src.onMouseMove(1, 1)
+	src.onMouseMove(2, 2)
+	src.onMouseMove(3, 3)
+
+	var last MouseMoveEvent
+	deadline := time.After(time.Second)
+	for i := 0; i < 2; i++ {
+		select {
+		case got := <-events:
+			ev, ok := got.(MouseMoveEvent)
+			if !ok {
+				t.Fatalf("got %T, want MouseMoveEvent", got)
+			}
+			last = ev
+		case <-deadline:
+			t.Fatalf("timed out waiting for mouse move %d", i)
+		}
+	}
+
+	if last.X != 3 || last.Y != 3 {
+		t.Errorf("final coalesced MouseMoveEvent = %+v, want (3, 3)", last)
+	}
+}
+
+func TestPumpContext_StopsOnCancel(t *testing.T) {
+	src := &fakeEventSource{}
+	ctx, cancelCtx := context.WithCancel(context.Background())
+
+	got := make(chan Event, 1)
+	done := make(chan struct{})
+	go func() {
+		PumpContext(ctx, src, func(ev Event) {
+			select {
+			case got <- ev:
+			default:
+			}
+		})
+		close(done)
+	}()
+
+	// Give PumpContext a chance to register its callbacks.
+	time.Sleep(10 * time.Millisecond)
+	src.onFocus(true)
+
+	select {
+	case ev := <-got:
+		if fe, ok := ev.(FocusEvent); !ok || !fe.Focused {
+			t.Errorf("got %#v, want FocusEvent{Focused: true}", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for FocusEvent")
+	}
+
+	cancelCtx()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("PumpContext did not return after context cancellation")
+	}
+}
@@ -12,6 +12,7 @@ func TestNullEventSource(t *testing.T) {
 	// All methods should be callable without panic
 	es.OnKeyPress(func(Key, Modifiers) {})
 	es.OnKeyRelease(func(Key, Modifiers) {})
+	es.OnKey(func(KeyEvent) {})
 	es.OnTextInput(func(string) {})
 	es.OnMouseMove(func(float64, float64) {})
 	es.OnMousePress(func(MouseButton, float64, float64) {})
@@ -138,6 +139,95 @@ func TestIMEStateZeroValue(t *testing.T) {
 	}
 }
 
+func TestKeyLocation_String(t *testing.T) {
+	tests := []struct {
+		location KeyLocation
+		want     string
+	}{
+		{KeyLocationStandard, "Standard"},
+		{KeyLocationLeft, "Left"},
+		{KeyLocationRight, "Right"},
+		{KeyLocationNumpad, "Numpad"},
+		{KeyLocation(99), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.location.String(); got != tt.want {
+				t.Errorf("KeyLocation(%d).String() = %q, want %q", tt.location, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKey_Location(t *testing.T) {
+	tests := []struct {
+		key  Key
+		want KeyLocation
+	}{
+		{KeyLeftShift, KeyLocationLeft},
+		{KeyLeftControl, KeyLocationLeft},
+		{KeyRightShift, KeyLocationRight},
+		{KeyRightAlt, KeyLocationRight},
+		{KeyNumpad5, KeyLocationNumpad},
+		{KeyNumpadEnter, KeyLocationNumpad},
+		{KeyA, KeyLocationStandard},
+		{KeyEnter, KeyLocationStandard},
+	}
+
+	for _, tt := range tests {
+		if got := tt.key.Location(); got != tt.want {
+			t.Errorf("Key(%d).Location() = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestSynthesizeKeyEvent(t *testing.T) {
+	ev := SynthesizeKeyEvent(KeyRightShift, ModShift, true, false)
+
+	if ev.PhysicalKey != KeyRightShift {
+		t.Errorf("PhysicalKey = %v, want KeyRightShift", ev.PhysicalKey)
+	}
+	if ev.LogicalKey != KeyRightShift {
+		t.Errorf("LogicalKey = %v, want KeyRightShift", ev.LogicalKey)
+	}
+	if ev.Location != KeyLocationRight {
+		t.Errorf("Location = %v, want KeyLocationRight", ev.Location)
+	}
+	if ev.Text != "" {
+		t.Errorf("Text = %q, want empty", ev.Text)
+	}
+	if !ev.Pressed {
+		t.Error("Pressed should be true")
+	}
+	if ev.Repeat {
+		t.Error("Repeat should be false")
+	}
+	if ev.Modifiers != ModShift {
+		t.Errorf("Modifiers = %v, want ModShift", ev.Modifiers)
+	}
+}
+
+func TestModifiers_LeftRight(t *testing.T) {
+	mods := ModLeftShift | ModRightAlt
+
+	if !mods.HasLeftShift() {
+		t.Error("HasLeftShift should be true")
+	}
+	if mods.HasRightShift() {
+		t.Error("HasRightShift should be false")
+	}
+	if !mods.HasRightAlt() {
+		t.Error("HasRightAlt should be true")
+	}
+	if mods.HasLeftControl() || mods.HasRightControl() {
+		t.Error("HasLeftControl/HasRightControl should be false")
+	}
+	if mods.HasLeftSuper() || mods.HasRightSuper() {
+		t.Error("HasLeftSuper/HasRightSuper should be false")
+	}
+}
+
 // mockIMEController is used to verify IMEController interface at compile time.
 type mockIMEController struct{}
 
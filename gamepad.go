@@ -0,0 +1,275 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "time"
+
+// GamepadID identifies a connected gamepad for the lifetime of its
+// connection. IDs may be reused after a gamepad disconnects.
+type GamepadID int
+
+// GamepadEventKind classifies a GamepadEvent.
+type GamepadEventKind uint8
+
+const (
+	// GamepadConnected indicates a new gamepad was detected.
+	GamepadConnected GamepadEventKind = iota
+
+	// GamepadDisconnected indicates a gamepad was removed.
+	GamepadDisconnected
+
+	// GamepadButtonPressed indicates a button transitioned to pressed.
+	GamepadButtonPressed
+
+	// GamepadButtonReleased indicates a button transitioned to released.
+	GamepadButtonReleased
+
+	// GamepadAxisChanged indicates an axis value changed.
+	GamepadAxisChanged
+)
+
+// String returns the event kind name for debugging.
+func (k GamepadEventKind) String() string {
+	switch k {
+	case GamepadConnected:
+		return "Connected"
+	case GamepadDisconnected:
+		return "Disconnected"
+	case GamepadButtonPressed:
+		return "ButtonPressed"
+	case GamepadButtonReleased:
+		return "ButtonReleased"
+	case GamepadAxisChanged:
+		return "AxisChanged"
+	default:
+		return "Unknown"
+	}
+}
+
+// ButtonState is the state of a single gamepad button.
+type ButtonState struct {
+	// Pressed is true if the button is held past its digital threshold.
+	Pressed bool
+
+	// Touched is true if the button supports and detects touch without a
+	// full press (e.g. some analog triggers and capacitive face buttons).
+	Touched bool
+
+	// Value is the analog button value, 0.0 (released) to 1.0 (fully
+	// pressed). Digital-only buttons report 0.0 or 1.0.
+	Value float32
+}
+
+// GamepadInfo describes a connected gamepad's identity and capabilities.
+type GamepadInfo struct {
+	// Name is the device's human-readable name, as reported by the
+	// platform (e.g. "Xbox Wireless Controller").
+	Name string
+
+	// VendorID is the USB vendor ID.
+	VendorID uint16
+
+	// ProductID is the USB product ID.
+	ProductID uint16
+
+	// GUID is an SDL-style device GUID, stable across connections of the
+	// same physical device and usable as a key into a mapping database.
+	GUID string
+
+	// AxisCount is the number of raw axes GamepadState reports for this
+	// device.
+	AxisCount int
+
+	// ButtonCount is the number of raw buttons GamepadState reports for
+	// this device.
+	ButtonCount int
+
+	// Standard is true if the platform maps this device's raw axes and
+	// buttons to the W3C "standard gamepad" layout (see StandardMapping),
+	// so StandardMapping.Apply is a pass-through rather than a remap.
+	Standard bool
+}
+
+// GamepadState is a snapshot of a gamepad's raw axis and button values.
+type GamepadState struct {
+	// Axes are raw axis values, one per axis, in -1.0..1.0.
+	Axes []float32
+
+	// Buttons are raw button states, one per button.
+	Buttons []ButtonState
+
+	// Timestamp is the time this state was sampled, as duration since an
+	// arbitrary reference. Zero if timestamps are not available.
+	Timestamp time.Duration
+}
+
+// GamepadEvent represents a gamepad connection, button, or axis event.
+type GamepadEvent struct {
+	// ID identifies which gamepad produced this event.
+	ID GamepadID
+
+	// Kind classifies this event.
+	Kind GamepadEventKind
+
+	// Info describes the gamepad. Only populated for GamepadConnected.
+	Info GamepadInfo
+
+	// ButtonIndex is the raw button index for GamepadButtonPressed/Released.
+	ButtonIndex int
+
+	// Button is the button state for GamepadButtonPressed/Released.
+	Button ButtonState
+
+	// AxisIndex is the raw axis index for GamepadAxisChanged.
+	AxisIndex int
+
+	// AxisValue is the new axis value, -1.0..1.0, for GamepadAxisChanged.
+	AxisValue float32
+
+	// Timestamp is the event time as duration since an arbitrary reference.
+	// Zero if timestamps are not available.
+	Timestamp time.Duration
+}
+
+// GamepadProvider provides access to connected gamepads, alongside
+// WindowProvider/PlatformProvider as another optional OS integration
+// capability.
+//
+// GamepadProvider is optional. Not all platforms expose gamepad input
+// (e.g. headless rendering). Use type assertion to check availability:
+//
+//	if gp, ok := provider.(gpucontext.GamepadProvider); ok {
+//	    for _, id := range gp.Gamepads() {
+//	        state := gp.GamepadState(id)
+//	    }
+//	}
+//
+// Platform backends (glfw, sdl, wasm's Gamepad API) register their
+// GamepadProvider implementation through GamepadProviders rather than
+// gpucontext importing them directly.
+type GamepadProvider interface {
+	// Gamepads returns the IDs of all currently connected gamepads.
+	Gamepads() []GamepadID
+
+	// GamepadInfo returns static information about the gamepad with the
+	// given ID. Returns false if no gamepad with that ID is connected.
+	GamepadInfo(id GamepadID) (GamepadInfo, bool)
+
+	// GamepadState returns the current raw axis/button state for the
+	// gamepad with the given ID. Returns the zero value if no gamepad
+	// with that ID is connected.
+	GamepadState(id GamepadID) GamepadState
+
+	// OnGamepadEvent registers a callback for connect, disconnect,
+	// button, and axis events.
+	//
+	// Callback threading: Called on the main/UI thread.
+	// Callbacks should be fast and non-blocking.
+	OnGamepadEvent(fn func(GamepadEvent))
+}
+
+// NullGamepadProvider implements GamepadProvider by reporting no gamepads
+// and ignoring all registrations. Used for testing and platforms without
+// gamepad support.
+type NullGamepadProvider struct{}
+
+// Gamepads returns nil.
+func (NullGamepadProvider) Gamepads() []GamepadID { return nil }
+
+// GamepadInfo returns the zero value and false.
+func (NullGamepadProvider) GamepadInfo(GamepadID) (GamepadInfo, bool) { return GamepadInfo{}, false }
+
+// GamepadState returns the zero value.
+func (NullGamepadProvider) GamepadState(GamepadID) GamepadState { return GamepadState{} }
+
+// OnGamepadEvent does nothing.
+func (NullGamepadProvider) OnGamepadEvent(func(GamepadEvent)) {}
+
+// Ensure NullGamepadProvider implements GamepadProvider.
+var _ GamepadProvider = NullGamepadProvider{}
+
+// GamepadProviders is the registry through which platform packages (glfw,
+// sdl, wasm) register their GamepadProvider backend, so gpucontext itself
+// has no hard dependency on any of them.
+//
+// Example:
+//
+//	gpucontext.GamepadProviders.Register("sdl", func() gpucontext.GamepadProvider {
+//	    return sdlgamepad.New()
+//	})
+var GamepadProviders = NewRegistry[GamepadProvider]()
+
+// Standard gamepad button indices, matching the W3C Gamepad API's
+// "standard" mapping. Use these to index StandardMapping.ButtonMap or the
+// Buttons slice of a GamepadState produced by StandardMapping.Apply.
+const (
+	StandardButtonA int = iota
+	StandardButtonB
+	StandardButtonX
+	StandardButtonY
+	StandardButtonLeftShoulder
+	StandardButtonRightShoulder
+	StandardButtonLeftTrigger
+	StandardButtonRightTrigger
+	StandardButtonSelect
+	StandardButtonStart
+	StandardButtonLeftStick
+	StandardButtonRightStick
+	StandardButtonDPadUp
+	StandardButtonDPadDown
+	StandardButtonDPadLeft
+	StandardButtonDPadRight
+	StandardButtonHome
+
+	standardButtonCount
+)
+
+// Standard gamepad axis indices, matching the W3C Gamepad API's "standard"
+// mapping: two analog sticks.
+const (
+	StandardAxisLeftStickX int = iota
+	StandardAxisLeftStickY
+	StandardAxisRightStickX
+	StandardAxisRightStickY
+
+	standardAxisCount
+)
+
+// StandardMapping translates a device's raw axis/button indices into the
+// standard gamepad layout (two sticks, dpad, ABXY, shoulders, triggers,
+// start/select/home), so downstream UI code doesn't have to special-case
+// each device's raw ordering.
+//
+// ButtonMap[i]/AxisMap[i] give the raw GamepadState index that corresponds
+// to standard index i (one of the StandardButton*/StandardAxis*
+// constants); a negative value means the device has no input for that
+// slot.
+type StandardMapping struct {
+	ButtonMap [standardButtonCount]int
+	AxisMap   [standardAxisCount]int
+}
+
+// Apply remaps raw into the standard gamepad layout using m. Standard
+// slots with no corresponding raw input (ButtonMap/AxisMap entry < 0, or
+// pointing past the end of raw's slices) are left at their zero value.
+func (m StandardMapping) Apply(raw GamepadState) GamepadState {
+	out := GamepadState{
+		Axes:      make([]float32, standardAxisCount),
+		Buttons:   make([]ButtonState, standardButtonCount),
+		Timestamp: raw.Timestamp,
+	}
+
+	for i, rawIdx := range m.AxisMap {
+		if rawIdx >= 0 && rawIdx < len(raw.Axes) {
+			out.Axes[i] = raw.Axes[rawIdx]
+		}
+	}
+	for i, rawIdx := range m.ButtonMap {
+		if rawIdx >= 0 && rawIdx < len(raw.Buttons) {
+			out.Buttons[i] = raw.Buttons[rawIdx]
+		}
+	}
+
+	return out
+}
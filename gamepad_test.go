@@ -0,0 +1,112 @@
+package gpucontext
+
+import "testing"
+
+func TestGamepadEventKind_String(t *testing.T) {
+	tests := []struct {
+		kind GamepadEventKind
+		want string
+	}{
+		{GamepadConnected, "Connected"},
+		{GamepadDisconnected, "Disconnected"},
+		{GamepadButtonPressed, "ButtonPressed"},
+		{GamepadButtonReleased, "ButtonReleased"},
+		{GamepadAxisChanged, "AxisChanged"},
+		{GamepadEventKind(99), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := tt.kind.String()
+			if got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullGamepadProvider(t *testing.T) {
+	var p NullGamepadProvider
+
+	if got := p.Gamepads(); got != nil {
+		t.Errorf("Gamepads() = %v, want nil", got)
+	}
+
+	if info, ok := p.GamepadInfo(0); ok || info != (GamepadInfo{}) {
+		t.Errorf("GamepadInfo() = %v, %v, want zero value, false", info, ok)
+	}
+
+	if got := p.GamepadState(0); len(got.Axes) != 0 || len(got.Buttons) != 0 {
+		t.Errorf("GamepadState() = %v, want zero value", got)
+	}
+
+	called := false
+	p.OnGamepadEvent(func(GamepadEvent) { called = true })
+	if called {
+		t.Error("NullGamepadProvider should not call the callback")
+	}
+
+	var _ GamepadProvider = NullGamepadProvider{}
+	var _ GamepadProvider = p
+}
+
+func TestGamepadProviders_Registry(t *testing.T) {
+	GamepadProviders.Register("test-gamepad-backend", func() GamepadProvider {
+		return NullGamepadProvider{}
+	})
+	defer GamepadProviders.Unregister("test-gamepad-backend")
+
+	if !GamepadProviders.Has("test-gamepad-backend") {
+		t.Error("Has(test-gamepad-backend) = false after registration")
+	}
+
+	if _, ok := GamepadProviders.Get("test-gamepad-backend").(GamepadProvider); !ok {
+		t.Error("Get(test-gamepad-backend) did not return a GamepadProvider")
+	}
+}
+
+func TestStandardMapping_Apply(t *testing.T) {
+	// A mapping where the device reports axes/buttons in reverse order
+	// relative to the standard layout's first two slots, and is missing
+	// input for the rest.
+	m := StandardMapping{
+		AxisMap: [standardAxisCount]int{1, 0, -1, -1},
+		ButtonMap: [standardButtonCount]int{
+			1, 0, -1, -1, -1, -1, -1, -1,
+			-1, -1, -1, -1, -1, -1, -1, -1, -1,
+		},
+	}
+
+	raw := GamepadState{
+		Axes:    []float32{0.5, -0.25},
+		Buttons: []ButtonState{{Pressed: true, Value: 1}, {Pressed: false, Value: 0.75}},
+	}
+
+	out := m.Apply(raw)
+
+	if len(out.Axes) != standardAxisCount {
+		t.Fatalf("len(Axes) = %d, want %d", len(out.Axes), standardAxisCount)
+	}
+	if out.Axes[StandardAxisLeftStickX] != -0.25 {
+		t.Errorf("Axes[LeftStickX] = %f, want -0.25", out.Axes[StandardAxisLeftStickX])
+	}
+	if out.Axes[StandardAxisLeftStickY] != 0.5 {
+		t.Errorf("Axes[LeftStickY] = %f, want 0.5", out.Axes[StandardAxisLeftStickY])
+	}
+	if out.Axes[StandardAxisRightStickX] != 0 {
+		t.Errorf("Axes[RightStickX] = %f, want 0 (unmapped)", out.Axes[StandardAxisRightStickX])
+	}
+
+	if len(out.Buttons) != standardButtonCount {
+		t.Fatalf("len(Buttons) = %d, want %d", len(out.Buttons), standardButtonCount)
+	}
+	if out.Buttons[StandardButtonA] != (ButtonState{Pressed: false, Value: 0.75}) {
+		t.Errorf("Buttons[A] = %v, want {false, false, 0.75}", out.Buttons[StandardButtonA])
+	}
+	if out.Buttons[StandardButtonB] != (ButtonState{Pressed: true, Value: 1}) {
+		t.Errorf("Buttons[B] = %v, want {true, false, 1}", out.Buttons[StandardButtonB])
+	}
+	if out.Buttons[StandardButtonX] != (ButtonState{}) {
+		t.Errorf("Buttons[X] = %v, want zero value (unmapped)", out.Buttons[StandardButtonX])
+	}
+}
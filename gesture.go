@@ -31,6 +31,13 @@ type GestureEvent struct {
 	// Gestures require at least 2 pointers.
 	NumPointers int
 
+	// Kind classifies this event, distinguishing the continuous
+	// multi-pointer update above from single-pointer tap/double-tap/
+	// long-press fallbacks reported through the same callback by
+	// GestureRecognizer. Zero value (GestureContinuous) matches the
+	// original, Kind-less behavior of this event.
+	Kind GestureKind
+
 	// ZoomDelta is the proportional zoom factor for this frame.
 	// 1.0 = no change, >1.0 = zoom in, <1.0 = zoom out.
 	// Computed from change in average distance from centroid.
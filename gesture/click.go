@@ -0,0 +1,230 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gesture
+
+import (
+	"math"
+	"time"
+
+	"github.com/gogpu/gpucontext"
+)
+
+// ClickKind classifies a ClickEvent.
+type ClickKind uint8
+
+const (
+	// ClickPress fires immediately when a pointer goes down, before it is
+	// known whether the contact will complete as a click.
+	ClickPress ClickKind = iota
+
+	// ClickClick fires when a pointer that pressed down completes a
+	// click: it came back up within the recognizer's duration/distance
+	// thresholds. NumClicks counts this click's position in a rapid
+	// successive-click streak (2 for a double-click, 3 for a
+	// triple-click, and so on).
+	ClickClick
+
+	// ClickCancel fires instead of ClickClick when a pressed pointer
+	// moved beyond the distance threshold, stayed down past the
+	// duration threshold, or was cancelled.
+	ClickCancel
+)
+
+// String returns the click kind name for debugging.
+func (k ClickKind) String() string {
+	switch k {
+	case ClickPress:
+		return "Press"
+	case ClickClick:
+		return "Click"
+	case ClickCancel:
+		return "Cancel"
+	default:
+		return "Unknown"
+	}
+}
+
+// ClickEvent represents one step of a click gesture: a press, a
+// completed click, or a cancelled press.
+type ClickEvent struct {
+	Kind        ClickKind
+	Position    gpucontext.Point
+	PointerType gpucontext.PointerType
+	Modifiers   gpucontext.Modifiers
+
+	// NumClicks counts this click's position in a rapid successive-click
+	// streak. Meaningful only for ClickClick; 1 for a single click, 2 for
+	// a double-click, and so on. Resets to 1 when a click lands outside
+	// the recognizer's multi-click interval or distance of the previous
+	// one.
+	NumClicks int
+
+	// PointerID identifies which pointer produced this event.
+	PointerID int
+
+	// Timestamp is the event time, copied from the triggering PointerEvent.
+	Timestamp time.Duration
+}
+
+func (ClickEvent) gestureEvent() {}
+
+// ClickRecognizerOption configures a ClickRecognizer constructed by
+// NewClickRecognizer.
+type ClickRecognizerOption func(*clickRecognizerConfig)
+
+type clickRecognizerConfig struct {
+	maxDuration        time.Duration
+	maxDistance        float64
+	multiClickInterval time.Duration
+	multiClickDistance float64
+}
+
+// WithClickMaxDuration sets the longest a pointer contact may last and
+// still complete as a click rather than a ClickCancel. Defaults to 300ms.
+func WithClickMaxDuration(d time.Duration) ClickRecognizerOption {
+	return func(c *clickRecognizerConfig) { c.maxDuration = d }
+}
+
+// WithClickMaxDistance sets the farthest a pointer may travel from its
+// press position and still complete as a click, in logical pixels.
+// Defaults to 10.
+func WithClickMaxDistance(px float64) ClickRecognizerOption {
+	return func(c *clickRecognizerConfig) { c.maxDistance = px }
+}
+
+// WithMultiClickInterval sets the longest gap between two clicks for them
+// to be combined into a multi-click streak (NumClicks > 1). Defaults to
+// 200ms.
+func WithMultiClickInterval(d time.Duration) ClickRecognizerOption {
+	return func(c *clickRecognizerConfig) { c.multiClickInterval = d }
+}
+
+// WithMultiClickDistance sets the farthest apart two clicks may land and
+// still be combined into a multi-click streak, in logical pixels.
+// Defaults to 10.
+func WithMultiClickDistance(px float64) ClickRecognizerOption {
+	return func(c *clickRecognizerConfig) { c.multiClickDistance = px }
+}
+
+type clickPress struct {
+	startPos  gpucontext.Point
+	startTime time.Duration
+	moved     bool
+}
+
+// ClickRecognizer turns a gpucontext.PointerEvent stream into ClickEvents,
+// tracking each pointer independently and resetting on
+// gpucontext.PointerCancel. ClickRecognizer is not safe for concurrent
+// use; call Feed from a single goroutine, matching the threading
+// contract of PointerEventSource.OnPointer.
+type ClickRecognizer struct {
+	maxDuration        time.Duration
+	maxDistance        float64
+	multiClickInterval time.Duration
+	multiClickDistance float64
+
+	pointers map[int]*clickPress
+
+	streak       int
+	lastPos      gpucontext.Point
+	lastClickAt  time.Duration
+	haveLastTime bool
+}
+
+// NewClickRecognizer creates a ClickRecognizer with the default
+// thresholds described on the With* options.
+func NewClickRecognizer(opts ...ClickRecognizerOption) *ClickRecognizer {
+	cfg := &clickRecognizerConfig{
+		maxDuration:        300 * time.Millisecond,
+		maxDistance:        10,
+		multiClickInterval: 200 * time.Millisecond,
+		multiClickDistance: 10,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return &ClickRecognizer{
+		maxDuration:        cfg.maxDuration,
+		maxDistance:        cfg.maxDistance,
+		multiClickInterval: cfg.multiClickInterval,
+		multiClickDistance: cfg.multiClickDistance,
+		pointers:           make(map[int]*clickPress),
+	}
+}
+
+// Feed implements Recognizer.
+func (c *ClickRecognizer) Feed(ev gpucontext.PointerEvent) []Event {
+	pos := gpucontext.Point{X: ev.X, Y: ev.Y}
+
+	switch ev.Kind {
+	case gpucontext.PointerDown:
+		c.pointers[ev.PointerID] = &clickPress{startPos: pos, startTime: ev.Timestamp}
+		return []Event{c.event(ClickPress, pos, ev, 0)}
+
+	case gpucontext.PointerMove:
+		p, ok := c.pointers[ev.PointerID]
+		if !ok {
+			return nil
+		}
+		if !p.moved && distance(p.startPos, pos) > c.maxDistance {
+			p.moved = true
+		}
+		return nil
+
+	case gpucontext.PointerUp:
+		p, ok := c.pointers[ev.PointerID]
+		if !ok {
+			return nil
+		}
+		delete(c.pointers, ev.PointerID)
+
+		if p.moved || ev.Timestamp-p.startTime > c.maxDuration {
+			return []Event{c.event(ClickCancel, pos, ev, 0)}
+		}
+		return []Event{c.event(ClickClick, pos, ev, c.nextStreak(pos, ev.Timestamp))}
+
+	case gpucontext.PointerCancel:
+		if _, ok := c.pointers[ev.PointerID]; !ok {
+			return nil
+		}
+		delete(c.pointers, ev.PointerID)
+		return []Event{c.event(ClickCancel, pos, ev, 0)}
+	}
+
+	return nil
+}
+
+// nextStreak advances the multi-click streak counter given a completed
+// click at pos/ts, returning the streak length (NumClicks) for this
+// click.
+func (c *ClickRecognizer) nextStreak(pos gpucontext.Point, ts time.Duration) int {
+	if c.haveLastTime && ts-c.lastClickAt <= c.multiClickInterval && distance(c.lastPos, pos) <= c.multiClickDistance {
+		c.streak++
+	} else {
+		c.streak = 1
+	}
+	c.lastPos, c.lastClickAt, c.haveLastTime = pos, ts, true
+	return c.streak
+}
+
+func (c *ClickRecognizer) event(kind ClickKind, pos gpucontext.Point, ev gpucontext.PointerEvent, numClicks int) Event {
+	return ClickEvent{
+		Kind:        kind,
+		Position:    pos,
+		PointerType: ev.PointerType,
+		Modifiers:   ev.Modifiers,
+		NumClicks:   numClicks,
+		PointerID:   ev.PointerID,
+		Timestamp:   ev.Timestamp,
+	}
+}
+
+// distance returns the Euclidean distance between two points.
+func distance(a, b gpucontext.Point) float64 {
+	return math.Hypot(b.X-a.X, b.Y-a.Y)
+}
+
+// Ensure ClickRecognizer implements Recognizer.
+var _ Recognizer = (*ClickRecognizer)(nil)
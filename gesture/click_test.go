@@ -0,0 +1,121 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gesture
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogpu/gpucontext"
+)
+
+func down(id int, x, y float64, ts time.Duration) gpucontext.PointerEvent {
+	return gpucontext.PointerEvent{Kind: gpucontext.PointerDown, PointerID: id, X: x, Y: y, Timestamp: ts}
+}
+
+func move(id int, x, y float64, ts time.Duration) gpucontext.PointerEvent {
+	return gpucontext.PointerEvent{Kind: gpucontext.PointerMove, PointerID: id, X: x, Y: y, Timestamp: ts}
+}
+
+func up(id int, x, y float64, ts time.Duration) gpucontext.PointerEvent {
+	return gpucontext.PointerEvent{Kind: gpucontext.PointerUp, PointerID: id, X: x, Y: y, Timestamp: ts}
+}
+
+func cancel(id int, ts time.Duration) gpucontext.PointerEvent {
+	return gpucontext.PointerEvent{Kind: gpucontext.PointerCancel, PointerID: id, Timestamp: ts}
+}
+
+func TestClickKind_String(t *testing.T) {
+	tests := []struct {
+		kind ClickKind
+		want string
+	}{
+		{ClickPress, "Press"},
+		{ClickClick, "Click"},
+		{ClickCancel, "Cancel"},
+		{ClickKind(99), "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.kind.String(); got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClickRecognizer_SingleClick(t *testing.T) {
+	r := NewClickRecognizer()
+
+	evs := r.Feed(down(1, 10, 10, 0))
+	if len(evs) != 1 || evs[0].(ClickEvent).Kind != ClickPress {
+		t.Fatalf("down: got %v, want one ClickPress", evs)
+	}
+
+	evs = r.Feed(up(1, 10, 10, 50*time.Millisecond))
+	if len(evs) != 1 {
+		t.Fatalf("up: got %d events, want 1", len(evs))
+	}
+	click := evs[0].(ClickEvent)
+	if click.Kind != ClickClick || click.NumClicks != 1 {
+		t.Errorf("click = %+v, want Kind=Click NumClicks=1", click)
+	}
+}
+
+func TestClickRecognizer_DoubleClick(t *testing.T) {
+	r := NewClickRecognizer()
+
+	r.Feed(down(1, 10, 10, 0))
+	r.Feed(up(1, 10, 10, 20*time.Millisecond))
+	r.Feed(down(1, 11, 11, 100*time.Millisecond))
+	evs := r.Feed(up(1, 11, 11, 120*time.Millisecond))
+
+	click := evs[0].(ClickEvent)
+	if click.Kind != ClickClick || click.NumClicks != 2 {
+		t.Errorf("second click = %+v, want Kind=Click NumClicks=2", click)
+	}
+}
+
+func TestClickRecognizer_MoveBeyondDistanceCancels(t *testing.T) {
+	r := NewClickRecognizer()
+
+	r.Feed(down(1, 0, 0, 0))
+	r.Feed(move(1, 100, 100, 10*time.Millisecond))
+	evs := r.Feed(up(1, 100, 100, 20*time.Millisecond))
+
+	if len(evs) != 1 || evs[0].(ClickEvent).Kind != ClickCancel {
+		t.Errorf("got %v, want one ClickCancel", evs)
+	}
+}
+
+func TestClickRecognizer_TooSlowCancels(t *testing.T) {
+	r := NewClickRecognizer()
+
+	r.Feed(down(1, 0, 0, 0))
+	evs := r.Feed(up(1, 0, 0, time.Second))
+
+	if len(evs) != 1 || evs[0].(ClickEvent).Kind != ClickCancel {
+		t.Errorf("got %v, want one ClickCancel", evs)
+	}
+}
+
+func TestClickRecognizer_Cancel(t *testing.T) {
+	r := NewClickRecognizer()
+
+	r.Feed(down(1, 0, 0, 0))
+	evs := r.Feed(cancel(1, 10*time.Millisecond))
+
+	if len(evs) != 1 || evs[0].(ClickEvent).Kind != ClickCancel {
+		t.Errorf("got %v, want one ClickCancel", evs)
+	}
+
+	// A cancel with no matching press should be a no-op.
+	if evs := r.Feed(cancel(1, 20*time.Millisecond)); evs != nil {
+		t.Errorf("stray cancel: got %v, want nil", evs)
+	}
+}
+
+func TestClickRecognizer_ImplementsRecognizer(t *testing.T) {
+	var _ Recognizer = NewClickRecognizer()
+}
@@ -0,0 +1,171 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gesture
+
+import (
+	"time"
+
+	"github.com/gogpu/gpucontext"
+)
+
+// DragKind classifies a DragEvent.
+type DragKind uint8
+
+const (
+	// DragStart fires once a pointer has moved beyond the recognizer's
+	// hysteresis threshold from its press position. DeltaX/DeltaY give
+	// the total movement from the press position to here.
+	DragStart DragKind = iota
+
+	// DragUpdate fires on every subsequent move of a dragging pointer.
+	// DeltaX/DeltaY give the movement since the previous DragStart or
+	// DragUpdate.
+	DragUpdate
+
+	// DragEnd fires when a dragging pointer is released or cancelled.
+	DragEnd
+)
+
+// String returns the drag kind name for debugging.
+func (k DragKind) String() string {
+	switch k {
+	case DragStart:
+		return "Start"
+	case DragUpdate:
+		return "Update"
+	case DragEnd:
+		return "End"
+	default:
+		return "Unknown"
+	}
+}
+
+// DragEvent represents one step of a drag gesture.
+type DragEvent struct {
+	Kind      DragKind
+	Position  gpucontext.Point
+	DeltaX    float64
+	DeltaY    float64
+	Modifiers gpucontext.Modifiers
+	PointerID int
+	Timestamp time.Duration
+}
+
+func (DragEvent) gestureEvent() {}
+
+// DragRecognizerOption configures a DragRecognizer constructed by
+// NewDragRecognizer.
+type DragRecognizerOption func(*dragRecognizerConfig)
+
+type dragRecognizerConfig struct {
+	threshold float64
+}
+
+// WithDragThreshold sets how far a pointer must move from its press
+// position before DragStart fires, in logical pixels. Defaults to 10.
+func WithDragThreshold(px float64) DragRecognizerOption {
+	return func(c *dragRecognizerConfig) { c.threshold = px }
+}
+
+type dragTracked struct {
+	startPos gpucontext.Point
+	lastPos  gpucontext.Point
+	dragging bool
+}
+
+// DragRecognizer turns a gpucontext.PointerEvent stream into DragEvents,
+// requiring a pointer to move beyond a hysteresis threshold before
+// DragStart fires, so small jitter on a press doesn't register as a
+// drag. DragRecognizer is not safe for concurrent use.
+type DragRecognizer struct {
+	threshold float64
+
+	pointers map[int]*dragTracked
+}
+
+// NewDragRecognizer creates a DragRecognizer with the default threshold
+// described on WithDragThreshold.
+func NewDragRecognizer(opts ...DragRecognizerOption) *DragRecognizer {
+	cfg := &dragRecognizerConfig{threshold: 10}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return &DragRecognizer{
+		threshold: cfg.threshold,
+		pointers:  make(map[int]*dragTracked),
+	}
+}
+
+// Feed implements Recognizer.
+func (r *DragRecognizer) Feed(ev gpucontext.PointerEvent) []Event {
+	pos := gpucontext.Point{X: ev.X, Y: ev.Y}
+
+	switch ev.Kind {
+	case gpucontext.PointerDown:
+		r.pointers[ev.PointerID] = &dragTracked{startPos: pos, lastPos: pos}
+		return nil
+
+	case gpucontext.PointerMove:
+		p, ok := r.pointers[ev.PointerID]
+		if !ok {
+			return nil
+		}
+
+		if !p.dragging {
+			if distance(p.startPos, pos) < r.threshold {
+				return nil
+			}
+			p.dragging = true
+			start := DragEvent{
+				Kind:      DragStart,
+				Position:  pos,
+				DeltaX:    pos.X - p.startPos.X,
+				DeltaY:    pos.Y - p.startPos.Y,
+				Modifiers: ev.Modifiers,
+				PointerID: ev.PointerID,
+				Timestamp: ev.Timestamp,
+			}
+			p.lastPos = pos
+			return []Event{start}
+		}
+
+		delta := DragEvent{
+			Kind:      DragUpdate,
+			Position:  pos,
+			DeltaX:    pos.X - p.lastPos.X,
+			DeltaY:    pos.Y - p.lastPos.Y,
+			Modifiers: ev.Modifiers,
+			PointerID: ev.PointerID,
+			Timestamp: ev.Timestamp,
+		}
+		p.lastPos = pos
+		return []Event{delta}
+
+	case gpucontext.PointerUp, gpucontext.PointerCancel:
+		p, ok := r.pointers[ev.PointerID]
+		if !ok {
+			return nil
+		}
+		delete(r.pointers, ev.PointerID)
+
+		if !p.dragging {
+			return nil
+		}
+		return []Event{DragEvent{
+			Kind:      DragEnd,
+			Position:  pos,
+			DeltaX:    pos.X - p.lastPos.X,
+			DeltaY:    pos.Y - p.lastPos.Y,
+			Modifiers: ev.Modifiers,
+			PointerID: ev.PointerID,
+			Timestamp: ev.Timestamp,
+		}}
+	}
+
+	return nil
+}
+
+// Ensure DragRecognizer implements Recognizer.
+var _ Recognizer = (*DragRecognizer)(nil)
@@ -0,0 +1,75 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gesture
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDragKind_String(t *testing.T) {
+	tests := []struct {
+		kind DragKind
+		want string
+	}{
+		{DragStart, "Start"},
+		{DragUpdate, "Update"},
+		{DragEnd, "End"},
+		{DragKind(99), "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.kind.String(); got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDragRecognizer_FullDrag(t *testing.T) {
+	r := NewDragRecognizer()
+
+	if evs := r.Feed(down(1, 0, 0, 0)); evs != nil {
+		t.Fatalf("down: got %v, want nil", evs)
+	}
+
+	// Small jitter below the threshold should not start a drag.
+	if evs := r.Feed(move(1, 2, 0, 5*time.Millisecond)); evs != nil {
+		t.Fatalf("jitter: got %v, want nil", evs)
+	}
+
+	evs := r.Feed(move(1, 20, 0, 10*time.Millisecond))
+	if len(evs) != 1 {
+		t.Fatalf("got %d events, want 1 DragStart", len(evs))
+	}
+	start := evs[0].(DragEvent)
+	if start.Kind != DragStart || start.DeltaX != 20 {
+		t.Errorf("start = %+v, want Kind=Start DeltaX=20", start)
+	}
+
+	evs = r.Feed(move(1, 30, 5, 20*time.Millisecond))
+	update := evs[0].(DragEvent)
+	if update.Kind != DragUpdate || update.DeltaX != 10 || update.DeltaY != 5 {
+		t.Errorf("update = %+v, want Kind=Update DeltaX=10 DeltaY=5", update)
+	}
+
+	evs = r.Feed(up(1, 30, 5, 30*time.Millisecond))
+	if len(evs) != 1 || evs[0].(DragEvent).Kind != DragEnd {
+		t.Errorf("up: got %v, want one DragEnd", evs)
+	}
+}
+
+func TestDragRecognizer_ReleaseBeforeThresholdProducesNoEvents(t *testing.T) {
+	r := NewDragRecognizer()
+
+	r.Feed(down(1, 0, 0, 0))
+	r.Feed(move(1, 2, 0, 5*time.Millisecond))
+	if evs := r.Feed(up(1, 2, 0, 10*time.Millisecond)); evs != nil {
+		t.Errorf("got %v, want nil: never started dragging", evs)
+	}
+}
+
+func TestDragRecognizer_ImplementsRecognizer(t *testing.T) {
+	var _ Recognizer = NewDragRecognizer()
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gesture
+
+import (
+	"math"
+	"time"
+
+	"github.com/gogpu/gpucontext"
+)
+
+// FlingEvent fires when a pointer is released while still moving fast
+// enough to count as a fling (kinetic scroll/fling-to-dismiss), carrying
+// the estimated release velocity.
+type FlingEvent struct {
+	Position gpucontext.Point
+
+	// VelocityX and VelocityY are the estimated release velocity, in
+	// logical pixels per second.
+	VelocityX float64
+	VelocityY float64
+
+	PointerID int
+	Timestamp time.Duration
+}
+
+func (FlingEvent) gestureEvent() {}
+
+// FlingRecognizerOption configures a FlingRecognizer constructed by
+// NewFlingRecognizer.
+type FlingRecognizerOption func(*flingRecognizerConfig)
+
+type flingRecognizerConfig struct {
+	minVelocity  float64
+	sampleWindow time.Duration
+}
+
+// WithFlingMinVelocity sets the slowest release speed, in logical pixels
+// per second, that still counts as a fling. Defaults to 200.
+func WithFlingMinVelocity(pxPerSecond float64) FlingRecognizerOption {
+	return func(c *flingRecognizerConfig) { c.minVelocity = pxPerSecond }
+}
+
+// WithFlingSampleWindow sets how far back FlingRecognizer looks when
+// estimating release velocity; samples older than this relative to the
+// pointer's most recent move are discarded. Defaults to 100ms.
+func WithFlingSampleWindow(d time.Duration) FlingRecognizerOption {
+	return func(c *flingRecognizerConfig) { c.sampleWindow = d }
+}
+
+type flingSample struct {
+	pos gpucontext.Point
+	ts  time.Duration
+}
+
+type flingTracked struct {
+	samples []flingSample
+}
+
+// FlingRecognizer turns a gpucontext.PointerEvent stream into
+// FlingEvents, estimating release velocity from the most recent samples
+// within its sample window. FlingRecognizer is not safe for concurrent
+// use.
+type FlingRecognizer struct {
+	minVelocity  float64
+	sampleWindow time.Duration
+
+	pointers map[int]*flingTracked
+}
+
+// NewFlingRecognizer creates a FlingRecognizer with the default
+// thresholds described on the With* options.
+func NewFlingRecognizer(opts ...FlingRecognizerOption) *FlingRecognizer {
+	cfg := &flingRecognizerConfig{
+		minVelocity:  200,
+		sampleWindow: 100 * time.Millisecond,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return &FlingRecognizer{
+		minVelocity:  cfg.minVelocity,
+		sampleWindow: cfg.sampleWindow,
+		pointers:     make(map[int]*flingTracked),
+	}
+}
+
+// Feed implements Recognizer.
+func (r *FlingRecognizer) Feed(ev gpucontext.PointerEvent) []Event {
+	pos := gpucontext.Point{X: ev.X, Y: ev.Y}
+
+	switch ev.Kind {
+	case gpucontext.PointerDown:
+		r.pointers[ev.PointerID] = &flingTracked{samples: []flingSample{{pos: pos, ts: ev.Timestamp}}}
+		return nil
+
+	case gpucontext.PointerMove:
+		p, ok := r.pointers[ev.PointerID]
+		if !ok {
+			return nil
+		}
+		p.samples = append(p.samples, flingSample{pos: pos, ts: ev.Timestamp})
+		p.samples = trimSamples(p.samples, r.sampleWindow)
+		return nil
+
+	case gpucontext.PointerUp:
+		p, ok := r.pointers[ev.PointerID]
+		if !ok {
+			return nil
+		}
+		delete(r.pointers, ev.PointerID)
+
+		vx, vy, ok := estimateVelocity(p.samples)
+		if !ok || math.Hypot(vx, vy) < r.minVelocity {
+			return nil
+		}
+		return []Event{FlingEvent{
+			Position:  pos,
+			VelocityX: vx,
+			VelocityY: vy,
+			PointerID: ev.PointerID,
+			Timestamp: ev.Timestamp,
+		}}
+
+	case gpucontext.PointerCancel:
+		delete(r.pointers, ev.PointerID)
+	}
+
+	return nil
+}
+
+// trimSamples drops samples older than window relative to the newest
+// sample.
+func trimSamples(samples []flingSample, window time.Duration) []flingSample {
+	if len(samples) == 0 {
+		return samples
+	}
+	cutoff := samples[len(samples)-1].ts - window
+	for i, s := range samples {
+		if s.ts >= cutoff {
+			return samples[i:]
+		}
+	}
+	return samples[len(samples)-1:]
+}
+
+// estimateVelocity computes velocity, in logical pixels per second, from
+// the oldest to the newest retained sample.
+func estimateVelocity(samples []flingSample) (vx, vy float64, ok bool) {
+	if len(samples) < 2 {
+		return 0, 0, false
+	}
+	first, last := samples[0], samples[len(samples)-1]
+	dt := (last.ts - first.ts).Seconds()
+	if dt <= 0 {
+		return 0, 0, false
+	}
+	return (last.pos.X - first.pos.X) / dt, (last.pos.Y - first.pos.Y) / dt, true
+}
+
+// Ensure FlingRecognizer implements Recognizer.
+var _ Recognizer = (*FlingRecognizer)(nil)
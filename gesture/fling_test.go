@@ -0,0 +1,73 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gesture
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/gogpu/gpucontext"
+)
+
+func TestFlingRecognizer_FastReleaseFires(t *testing.T) {
+	r := NewFlingRecognizer()
+
+	r.Feed(down(1, 0, 0, 0))
+	r.Feed(move(1, 50, 0, 10*time.Millisecond))
+	evs := r.Feed(up(1, 100, 0, 20*time.Millisecond))
+
+	if len(evs) != 1 {
+		t.Fatalf("got %d events, want 1", len(evs))
+	}
+	fling := evs[0].(FlingEvent)
+	if fling.VelocityX <= 0 {
+		t.Errorf("VelocityX = %f, want positive", fling.VelocityX)
+	}
+}
+
+func TestFlingRecognizer_SlowReleaseDoesNotFire(t *testing.T) {
+	r := NewFlingRecognizer()
+
+	r.Feed(down(1, 0, 0, 0))
+	r.Feed(move(1, 1, 0, 50*time.Millisecond))
+	evs := r.Feed(up(1, 2, 0, 100*time.Millisecond))
+
+	if evs != nil {
+		t.Errorf("got %v, want nil for a slow release", evs)
+	}
+}
+
+func TestFlingRecognizer_CancelDiscardsTracking(t *testing.T) {
+	r := NewFlingRecognizer()
+
+	r.Feed(down(1, 0, 0, 0))
+	r.Feed(move(1, 200, 0, 10*time.Millisecond))
+	r.Feed(cancel(1, 20*time.Millisecond))
+
+	// Pointer id reused after cancel should start fresh, not fling off
+	// the discarded samples.
+	r.Feed(down(1, 0, 0, 30*time.Millisecond))
+	evs := r.Feed(up(1, 1, 0, 130*time.Millisecond))
+	if evs != nil {
+		t.Errorf("got %v, want nil after cancel reset tracking", evs)
+	}
+}
+
+func TestEstimateVelocity(t *testing.T) {
+	vx, vy, ok := estimateVelocity([]flingSample{
+		{pos: gpucontext.Point{X: 0, Y: 0}, ts: 0},
+		{pos: gpucontext.Point{X: 100, Y: 50}, ts: 100 * time.Millisecond},
+	})
+	if !ok {
+		t.Fatal("estimateVelocity: ok = false, want true")
+	}
+	if math.Abs(vx-1000) > 1e-9 || math.Abs(vy-500) > 1e-9 {
+		t.Errorf("vx, vy = %f, %f, want 1000, 500", vx, vy)
+	}
+}
+
+func TestFlingRecognizer_ImplementsRecognizer(t *testing.T) {
+	var _ Recognizer = NewFlingRecognizer()
+}
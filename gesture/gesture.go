@@ -0,0 +1,44 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+// Package gesture recognizes higher-level gestures (click, long-press,
+// hover, drag, fling) from a gpucontext.PointerEventSource stream,
+// roughly the abstraction Gio's gesture package provides, re-cast against
+// gpucontext.PointerEvent. Each recognizer is a small state machine keyed
+// by PointerID, reset on gpucontext.PointerCancel, and several can be
+// composed over the same source:
+//
+//	click := gesture.NewClickRecognizer()
+//	drag := gesture.NewDragRecognizer()
+//	source.OnPointer(func(ev gpucontext.PointerEvent) {
+//	    for _, e := range click.Feed(ev) {
+//	        handleClick(e.(gesture.ClickEvent))
+//	    }
+//	    for _, e := range drag.Feed(ev) {
+//	        handleDrag(e.(gesture.DragEvent))
+//	    }
+//	})
+package gesture
+
+import "github.com/gogpu/gpucontext"
+
+// Event is implemented by every concrete gesture event type (ClickEvent,
+// LongPressEvent, HoverEvent, DragEvent, FlingEvent) returned from
+// Recognizer.Feed. Event types are purpose-built per recognizer rather
+// than one general-purpose struct; type-switch or type-assert on the
+// concrete type to read its fields.
+type Event interface {
+	// gestureEvent is unexported so only this package's event types can
+	// implement Event.
+	gestureEvent()
+}
+
+// Recognizer consumes a gpucontext.PointerEvent stream and produces zero
+// or more higher-level gesture events. Several recognizers can be
+// composed over the same PointerEventSource, each tracking its own
+// per-PointerID state independently.
+type Recognizer interface {
+	// Feed processes one PointerEvent and returns any gesture events it
+	// completed. Most calls produce none.
+	Feed(ev gpucontext.PointerEvent) []Event
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gesture
+
+import (
+	"time"
+
+	"github.com/gogpu/gpucontext"
+)
+
+// HoverKind classifies a HoverEvent.
+type HoverKind uint8
+
+const (
+	// HoverEnter fires when a pointer enters tracking, mirroring
+	// gpucontext.PointerEnter.
+	HoverEnter HoverKind = iota
+
+	// HoverLeave fires when a tracked pointer leaves, mirroring
+	// gpucontext.PointerLeave, or is cancelled.
+	HoverLeave
+)
+
+// String returns the hover kind name for debugging.
+func (k HoverKind) String() string {
+	switch k {
+	case HoverEnter:
+		return "Enter"
+	case HoverLeave:
+		return "Leave"
+	default:
+		return "Unknown"
+	}
+}
+
+// HoverEvent represents a pointer entering or leaving, tracked per
+// PointerID.
+type HoverEvent struct {
+	Kind        HoverKind
+	Position    gpucontext.Point
+	PointerType gpucontext.PointerType
+	PointerID   int
+	Timestamp   time.Duration
+}
+
+func (HoverEvent) gestureEvent() {}
+
+// HoverRecognizer turns gpucontext.PointerEnter/PointerLeave/PointerCancel
+// events into HoverEvents, tracking which pointers are currently hovering
+// so a PointerCancel received without a matching PointerEnter is ignored.
+// HoverRecognizer is not safe for concurrent use.
+type HoverRecognizer struct {
+	hovering map[int]struct{}
+}
+
+// NewHoverRecognizer creates a HoverRecognizer.
+func NewHoverRecognizer() *HoverRecognizer {
+	return &HoverRecognizer{hovering: make(map[int]struct{})}
+}
+
+// Feed implements Recognizer.
+func (r *HoverRecognizer) Feed(ev gpucontext.PointerEvent) []Event {
+	pos := gpucontext.Point{X: ev.X, Y: ev.Y}
+
+	switch ev.Kind {
+	case gpucontext.PointerEnter:
+		r.hovering[ev.PointerID] = struct{}{}
+		return []Event{HoverEvent{Kind: HoverEnter, Position: pos, PointerType: ev.PointerType, PointerID: ev.PointerID, Timestamp: ev.Timestamp}}
+
+	case gpucontext.PointerLeave, gpucontext.PointerCancel:
+		if _, ok := r.hovering[ev.PointerID]; !ok {
+			return nil
+		}
+		delete(r.hovering, ev.PointerID)
+		return []Event{HoverEvent{Kind: HoverLeave, Position: pos, PointerType: ev.PointerType, PointerID: ev.PointerID, Timestamp: ev.Timestamp}}
+	}
+
+	return nil
+}
+
+// Ensure HoverRecognizer implements Recognizer.
+var _ Recognizer = (*HoverRecognizer)(nil)
@@ -0,0 +1,69 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gesture
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogpu/gpucontext"
+)
+
+func TestHoverKind_String(t *testing.T) {
+	tests := []struct {
+		kind HoverKind
+		want string
+	}{
+		{HoverEnter, "Enter"},
+		{HoverLeave, "Leave"},
+		{HoverKind(99), "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.kind.String(); got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHoverRecognizer_EnterLeave(t *testing.T) {
+	r := NewHoverRecognizer()
+
+	enter := gpucontext.PointerEvent{Kind: gpucontext.PointerEnter, PointerID: 1, X: 5, Y: 5, Timestamp: 0}
+	evs := r.Feed(enter)
+	if len(evs) != 1 || evs[0].(HoverEvent).Kind != HoverEnter {
+		t.Fatalf("enter: got %v", evs)
+	}
+
+	leave := gpucontext.PointerEvent{Kind: gpucontext.PointerLeave, PointerID: 1, X: 5, Y: 5, Timestamp: 10}
+	evs = r.Feed(leave)
+	if len(evs) != 1 || evs[0].(HoverEvent).Kind != HoverLeave {
+		t.Fatalf("leave: got %v", evs)
+	}
+}
+
+func TestHoverRecognizer_StrayLeaveIgnored(t *testing.T) {
+	r := NewHoverRecognizer()
+
+	leave := gpucontext.PointerEvent{Kind: gpucontext.PointerLeave, PointerID: 1}
+	if evs := r.Feed(leave); evs != nil {
+		t.Errorf("got %v, want nil for leave without matching enter", evs)
+	}
+}
+
+func TestHoverRecognizer_CancelActsAsLeave(t *testing.T) {
+	r := NewHoverRecognizer()
+
+	r.Feed(gpucontext.PointerEvent{Kind: gpucontext.PointerEnter, PointerID: 1})
+	evs := r.Feed(cancel(1, time.Millisecond))
+
+	if len(evs) != 1 || evs[0].(HoverEvent).Kind != HoverLeave {
+		t.Errorf("got %v, want one HoverLeave", evs)
+	}
+}
+
+func TestHoverRecognizer_ImplementsRecognizer(t *testing.T) {
+	var _ Recognizer = NewHoverRecognizer()
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gesture
+
+import (
+	"time"
+
+	"github.com/gogpu/gpucontext"
+)
+
+// LongPressEvent fires once when a pointer stays within the recognizer's
+// slop radius for at least its long-press duration.
+type LongPressEvent struct {
+	Position    gpucontext.Point
+	PointerType gpucontext.PointerType
+	Modifiers   gpucontext.Modifiers
+	PointerID   int
+	Timestamp   time.Duration
+}
+
+func (LongPressEvent) gestureEvent() {}
+
+// LongPressRecognizerOption configures a LongPressRecognizer constructed
+// by NewLongPressRecognizer.
+type LongPressRecognizerOption func(*longPressRecognizerConfig)
+
+type longPressRecognizerConfig struct {
+	duration   time.Duration
+	slopRadius float64
+}
+
+// WithLongPressDuration sets how long a pointer must stay within the slop
+// radius before a LongPressEvent fires. Defaults to 500ms.
+func WithLongPressDuration(d time.Duration) LongPressRecognizerOption {
+	return func(c *longPressRecognizerConfig) { c.duration = d }
+}
+
+// WithLongPressSlopRadius sets how far a pointer may move from its press
+// position without disqualifying it from long-press, in logical pixels.
+// Defaults to 10.
+func WithLongPressSlopRadius(px float64) LongPressRecognizerOption {
+	return func(c *longPressRecognizerConfig) { c.slopRadius = px }
+}
+
+type longPressTracked struct {
+	startPos  gpucontext.Point
+	startTime time.Duration
+	moved     bool
+	fired     bool
+	pointerTy gpucontext.PointerType
+	mods      gpucontext.Modifiers
+}
+
+// LongPressRecognizer turns a gpucontext.PointerEvent stream into
+// LongPressEvents. Since long-press detection needs a notion of elapsed
+// wall-clock time beyond what pointer events alone provide, the host must
+// call Tick periodically (e.g. once per frame) in addition to Feed.
+// LongPressRecognizer is not safe for concurrent use.
+type LongPressRecognizer struct {
+	duration   time.Duration
+	slopRadius float64
+
+	pointers map[int]*longPressTracked
+}
+
+// NewLongPressRecognizer creates a LongPressRecognizer with the default
+// thresholds described on the With* options.
+func NewLongPressRecognizer(opts ...LongPressRecognizerOption) *LongPressRecognizer {
+	cfg := &longPressRecognizerConfig{
+		duration:   500 * time.Millisecond,
+		slopRadius: 10,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return &LongPressRecognizer{
+		duration:   cfg.duration,
+		slopRadius: cfg.slopRadius,
+		pointers:   make(map[int]*longPressTracked),
+	}
+}
+
+// Feed implements Recognizer. Feed alone never produces a LongPressEvent;
+// call Tick to drive firing.
+func (r *LongPressRecognizer) Feed(ev gpucontext.PointerEvent) []Event {
+	pos := gpucontext.Point{X: ev.X, Y: ev.Y}
+
+	switch ev.Kind {
+	case gpucontext.PointerDown:
+		r.pointers[ev.PointerID] = &longPressTracked{
+			startPos:  pos,
+			startTime: ev.Timestamp,
+			pointerTy: ev.PointerType,
+			mods:      ev.Modifiers,
+		}
+
+	case gpucontext.PointerMove:
+		p, ok := r.pointers[ev.PointerID]
+		if !ok {
+			return nil
+		}
+		if !p.moved && distance(p.startPos, pos) > r.slopRadius {
+			p.moved = true
+		}
+
+	case gpucontext.PointerUp, gpucontext.PointerCancel:
+		delete(r.pointers, ev.PointerID)
+	}
+
+	return nil
+}
+
+// Tick drives long-press detection. It should be called once per frame
+// with the current time, using the same reference as the timestamps
+// passed via Feed. A LongPressEvent fires at most once per pointer.
+func (r *LongPressRecognizer) Tick(now time.Duration) []Event {
+	var events []Event
+	for id, p := range r.pointers {
+		if p.moved || p.fired || now-p.startTime < r.duration {
+			continue
+		}
+		p.fired = true
+		events = append(events, LongPressEvent{
+			Position:    p.startPos,
+			PointerType: p.pointerTy,
+			Modifiers:   p.mods,
+			PointerID:   id,
+			Timestamp:   now,
+		})
+	}
+	return events
+}
+
+// Ensure LongPressRecognizer implements Recognizer.
+var _ Recognizer = (*LongPressRecognizer)(nil)
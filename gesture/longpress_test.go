@@ -0,0 +1,58 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gesture
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLongPressRecognizer_Fires(t *testing.T) {
+	r := NewLongPressRecognizer()
+
+	r.Feed(down(1, 10, 10, 0))
+	if evs := r.Tick(100 * time.Millisecond); evs != nil {
+		t.Errorf("too early: got %v, want nil", evs)
+	}
+
+	evs := r.Tick(600 * time.Millisecond)
+	if len(evs) != 1 {
+		t.Fatalf("got %d events, want 1", len(evs))
+	}
+	lp := evs[0].(LongPressEvent)
+	if lp.PointerID != 1 {
+		t.Errorf("PointerID = %d, want 1", lp.PointerID)
+	}
+
+	// Fires only once.
+	if evs := r.Tick(700 * time.Millisecond); evs != nil {
+		t.Errorf("second tick: got %v, want nil", evs)
+	}
+}
+
+func TestLongPressRecognizer_MoveCancels(t *testing.T) {
+	r := NewLongPressRecognizer()
+
+	r.Feed(down(1, 0, 0, 0))
+	r.Feed(move(1, 100, 100, 10*time.Millisecond))
+
+	if evs := r.Tick(600 * time.Millisecond); evs != nil {
+		t.Errorf("got %v, want nil after move beyond slop", evs)
+	}
+}
+
+func TestLongPressRecognizer_UpCancels(t *testing.T) {
+	r := NewLongPressRecognizer()
+
+	r.Feed(down(1, 0, 0, 0))
+	r.Feed(up(1, 0, 0, 10*time.Millisecond))
+
+	if evs := r.Tick(600 * time.Millisecond); evs != nil {
+		t.Errorf("got %v, want nil after release", evs)
+	}
+}
+
+func TestLongPressRecognizer_ImplementsRecognizer(t *testing.T) {
+	var _ Recognizer = NewLongPressRecognizer()
+}
@@ -0,0 +1,456 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// GestureKind classifies the GestureEvent delivered by GestureRecognizer.
+type GestureKind uint8
+
+const (
+	// GestureContinuous is a multi-pointer pinch/zoom/rotate/pan update,
+	// the original GestureEvent shape computed from 2+ active pointers.
+	// Zero value, for backward compatibility with callers that only
+	// ever dealt with the continuous gesture shape.
+	GestureContinuous GestureKind = iota
+
+	// GestureTap is a single-pointer tap: down and up within
+	// GestureRecognizer's tap duration/distance thresholds.
+	GestureTap
+
+	// GestureDoubleTap is two taps landing within the recognizer's
+	// double-tap interval and distance of each other.
+	GestureDoubleTap
+
+	// GestureLongPress is a single pointer that stayed within the slop
+	// radius for at least the recognizer's long-press duration.
+	GestureLongPress
+)
+
+// String returns the gesture kind name for debugging.
+func (k GestureKind) String() string {
+	switch k {
+	case GestureContinuous:
+		return "Continuous"
+	case GestureTap:
+		return "Tap"
+	case GestureDoubleTap:
+		return "DoubleTap"
+	case GestureLongPress:
+		return "LongPress"
+	default:
+		return "Unknown"
+	}
+}
+
+// GestureRecognizerOption configures a GestureRecognizer constructed by
+// NewGestureRecognizer.
+type GestureRecognizerOption func(*gestureRecognizerConfig)
+
+type gestureRecognizerConfig struct {
+	tapMaxDuration    time.Duration
+	tapMaxDistance    float64
+	doubleTapInterval time.Duration
+	longPressDuration time.Duration
+	slopRadius        float64
+}
+
+// WithTapMaxDuration sets the longest a pointer contact may last and still
+// count as a tap. Defaults to 300ms.
+func WithTapMaxDuration(d time.Duration) GestureRecognizerOption {
+	return func(c *gestureRecognizerConfig) { c.tapMaxDuration = d }
+}
+
+// WithTapMaxDistance sets the farthest a pointer may travel from its start
+// position and still count as a tap, in logical pixels. Defaults to 10.
+func WithTapMaxDistance(px float64) GestureRecognizerOption {
+	return func(c *gestureRecognizerConfig) { c.tapMaxDistance = px }
+}
+
+// WithDoubleTapInterval sets the longest gap between two taps for them to
+// be combined into a GestureDoubleTap event. Defaults to 300ms.
+func WithDoubleTapInterval(d time.Duration) GestureRecognizerOption {
+	return func(c *gestureRecognizerConfig) { c.doubleTapInterval = d }
+}
+
+// WithLongPressDuration sets how long a pointer must stay within the slop
+// radius before GestureLongPress fires. Defaults to 500ms.
+func WithLongPressDuration(d time.Duration) GestureRecognizerOption {
+	return func(c *gestureRecognizerConfig) { c.longPressDuration = d }
+}
+
+// WithSlopRadius sets how far a single pointer may move from its start
+// position before its gesture is no longer eligible for tap/long-press,
+// in logical pixels. Defaults to 10.
+func WithSlopRadius(px float64) GestureRecognizerOption {
+	return func(c *gestureRecognizerConfig) { c.slopRadius = px }
+}
+
+type gesturePointer struct {
+	x, y float64
+}
+
+type gestureSingle struct {
+	id        int
+	startPos  Point
+	startTime time.Duration
+	moved     bool
+	longFired bool
+}
+
+// GestureRecognizer turns a stream of raw pointer primitives (pointer ID,
+// position, timestamp) into GestureEvents, delivered to any callback
+// registered via OnGesture. It is the push-model counterpart to the
+// GestureEvent type: rather than being fed a platform PointerEventSource
+// directly, it is driven by explicit Begin/Move/End/Cancel calls, so it
+// can sit behind any input source (real platform backend, replay,
+// synthetic injection) without depending on PointerEvent's full shape.
+//
+// With two or more pointers active, Move only records the new pointer
+// position; Frame is what turns the accumulated positions into at most
+// one GestureContinuous event per call, carrying zoom, per-axis zoom,
+// rotation, and translation deltas computed relative to the previous
+// Frame's baseline (following the same zoom/rotate/pan math as
+// GestureEvent). With exactly one pointer active, GestureRecognizer
+// instead watches for tap, double-tap, and long-press fallbacks,
+// mirroring TouchGestureRecognizer's single-touch state machine.
+// Long-press detection likewise needs a notion of elapsed wall-clock time
+// beyond what pointer events alone provide, so the host must call Frame
+// (or Tick, if it wants long-press detection without continuous-gesture
+// batching) periodically, e.g. once per frame.
+//
+// GestureRecognizer is safe for concurrent use.
+type GestureRecognizer struct {
+	tapMaxDuration    time.Duration
+	tapMaxDistance    float64
+	doubleTapInterval time.Duration
+	longPressDuration time.Duration
+	slopRadius        float64
+
+	mu       sync.Mutex
+	order    []int
+	pointers map[int]*gesturePointer
+	single   *gestureSingle
+
+	haveBaseline bool
+	prevDist     float64
+	prevAngle    float64
+	prevDX       float64
+	prevDY       float64
+	prevCentroid Point
+
+	lastTap     Point
+	lastTapAt   time.Duration
+	haveLastTap bool
+
+	onGesture func(GestureEvent)
+}
+
+// NewGestureRecognizer creates a GestureRecognizer with the default
+// thresholds described on the With* options, which can be overridden to
+// tune tap/long-press detection per platform.
+func NewGestureRecognizer(opts ...GestureRecognizerOption) *GestureRecognizer {
+	cfg := &gestureRecognizerConfig{
+		tapMaxDuration:    300 * time.Millisecond,
+		tapMaxDistance:    10,
+		doubleTapInterval: 300 * time.Millisecond,
+		longPressDuration: 500 * time.Millisecond,
+		slopRadius:        10,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return &GestureRecognizer{
+		tapMaxDuration:    cfg.tapMaxDuration,
+		tapMaxDistance:    cfg.tapMaxDistance,
+		doubleTapInterval: cfg.doubleTapInterval,
+		longPressDuration: cfg.longPressDuration,
+		slopRadius:        cfg.slopRadius,
+		pointers:          make(map[int]*gesturePointer),
+	}
+}
+
+// NewGestureRecognizerFromPointerSource creates a GestureRecognizer and
+// wires it to src, translating PointerDown/PointerMove/PointerUp/
+// PointerCancel into the corresponding Begin/Move/End/Cancel calls. This
+// gives any backend that only delivers raw pointer events - X11, older
+// Wayland, headless test harnesses - a working GestureEventSource for
+// free, instead of falling back to NullGestureEventSource.
+//
+// The returned recognizer still needs Frame called once per frame for
+// long-press detection and batched continuous-gesture delivery; see
+// Frame.
+func NewGestureRecognizerFromPointerSource(src PointerEventSource, opts ...GestureRecognizerOption) *GestureRecognizer {
+	g := NewGestureRecognizer(opts...)
+	src.OnPointer(func(ev PointerEvent) {
+		switch ev.Kind {
+		case PointerDown:
+			g.Begin(ev.PointerID, ev.X, ev.Y, ev.Timestamp)
+		case PointerMove:
+			g.Move(ev.PointerID, ev.X, ev.Y, ev.Timestamp)
+		case PointerUp:
+			g.End(ev.PointerID, ev.X, ev.Y, ev.Timestamp)
+		case PointerCancel:
+			g.Cancel(ev.PointerID)
+		}
+	})
+	return g
+}
+
+// OnGesture registers fn to receive computed GestureEvents, implementing
+// GestureEventSource.
+func (g *GestureRecognizer) OnGesture(fn func(GestureEvent)) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.onGesture = fn
+}
+
+// Begin starts tracking a new pointer (pointer down) at (x, y).
+func (g *GestureRecognizer) Begin(pointerID int, x, y float64, ts time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.pointers[pointerID] = &gesturePointer{x: x, y: y}
+	g.order = append(g.order, pointerID)
+
+	if len(g.pointers) == 1 {
+		g.single = &gestureSingle{id: pointerID, startPos: Point{X: x, Y: y}, startTime: ts}
+		return
+	}
+
+	g.single = nil
+	g.rebaseline()
+}
+
+// Move updates the position of a tracked pointer (pointer move).
+func (g *GestureRecognizer) Move(pointerID int, x, y float64, ts time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	p, ok := g.pointers[pointerID]
+	if !ok {
+		return
+	}
+	p.x, p.y = x, y
+
+	if g.single != nil && g.single.id == pointerID {
+		if !g.single.moved && distance(g.single.startPos, Point{X: x, Y: y}) > g.slopRadius {
+			g.single.moved = true
+		}
+	}
+}
+
+// End stops tracking a pointer (pointer up), completing any tap,
+// double-tap, or long-press it represents at (x, y).
+func (g *GestureRecognizer) End(pointerID int, x, y float64, ts time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if p, ok := g.pointers[pointerID]; ok {
+		p.x, p.y = x, y
+	}
+	g.remove(pointerID)
+
+	if g.single == nil || g.single.id != pointerID {
+		return
+	}
+	s := g.single
+	g.single = nil
+
+	if s.moved || s.longFired || ts-s.startTime > g.tapMaxDuration {
+		return
+	}
+
+	pos := Point{X: x, Y: y}
+	if g.haveLastTap && ts-g.lastTapAt <= g.doubleTapInterval && distance(g.lastTap, pos) <= g.tapMaxDistance {
+		g.haveLastTap = false
+		g.emitSingle(GestureDoubleTap, pos, ts)
+		return
+	}
+
+	g.lastTap, g.lastTapAt, g.haveLastTap = pos, ts, true
+	g.emitSingle(GestureTap, pos, ts)
+}
+
+// Cancel discards a pointer (pointer cancel) without completing any
+// gesture.
+func (g *GestureRecognizer) Cancel(pointerID int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.remove(pointerID)
+	if g.single != nil && g.single.id == pointerID {
+		g.single = nil
+	}
+}
+
+// Tick drives long-press detection. It should be called once per frame
+// with the current time (using the same reference as the timestamps
+// passed to Begin/Move/End). A long-press fires at most once per pointer.
+//
+// Tick does not diff or emit continuous gestures; see Frame.
+func (g *GestureRecognizer) Tick(now time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.tick(now)
+}
+
+func (g *GestureRecognizer) tick(now time.Duration) {
+	s := g.single
+	if s == nil || s.moved || s.longFired {
+		return
+	}
+	if now-s.startTime < g.longPressDuration {
+		return
+	}
+
+	s.longFired = true
+	g.emitSingle(GestureLongPress, s.startPos, now)
+}
+
+// Frame drives GestureRecognizer from a per-frame render loop (the Vello
+// "compute once per frame" convention): it performs Tick's long-press
+// bookkeeping, then diffs the pointer positions accumulated by Move since
+// the previous Frame call against that frame's baseline, emitting at most
+// one GestureContinuous event for the frame and updating the baseline for
+// the next one. Move only records raw pointer positions; Frame is what
+// turns them into a gesture update, so rapid Moves between frames are
+// coalesced into a single delta instead of one event per Move.
+func (g *GestureRecognizer) Frame(now time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.tick(now)
+	g.emitContinuous(now)
+}
+
+func (g *GestureRecognizer) remove(pointerID int) {
+	delete(g.pointers, pointerID)
+	for i, id := range g.order {
+		if id == pointerID {
+			g.order = append(g.order[:i], g.order[i+1:]...)
+			break
+		}
+	}
+	g.rebaseline()
+}
+
+// rebaseline recomputes the distance/angle/centroid reference used to
+// compute deltas for the next two-pointer update, or clears it when fewer
+// than two pointers remain. It must run whenever the active pointer set
+// changes, so an added or removed pointer doesn't produce a spurious
+// large delta on the next Frame.
+func (g *GestureRecognizer) rebaseline() {
+	p1, p2, ok := g.twoFingers()
+	if !ok {
+		g.haveBaseline = false
+		return
+	}
+
+	dx, dy := p2.x-p1.x, p2.y-p1.y
+	g.prevDist = math.Hypot(dx, dy)
+	g.prevAngle = math.Atan2(dy, dx)
+	g.prevDX, g.prevDY = dx, dy
+	g.prevCentroid = Point{X: (p1.x + p2.x) / 2, Y: (p1.y + p2.y) / 2}
+	g.haveBaseline = true
+}
+
+func (g *GestureRecognizer) emitContinuous(ts time.Duration) {
+	p1, p2, ok := g.twoFingers()
+	if !ok {
+		return
+	}
+
+	dx, dy := p2.x-p1.x, p2.y-p1.y
+	dist := math.Hypot(dx, dy)
+	angle := math.Atan2(dy, dx)
+	centroid := Point{X: (p1.x + p2.x) / 2, Y: (p1.y + p2.y) / 2}
+
+	if !g.haveBaseline {
+		g.prevDist, g.prevAngle, g.prevDX, g.prevDY, g.prevCentroid = dist, angle, dx, dy, centroid
+		g.haveBaseline = true
+		return
+	}
+
+	zoom := 1.0
+	if g.prevDist != 0 {
+		zoom = dist / g.prevDist
+	}
+	zoomX, zoomY := 1.0, 1.0
+	if g.prevDX != 0 {
+		zoomX = math.Abs(dx) / math.Abs(g.prevDX)
+	}
+	if g.prevDY != 0 {
+		zoomY = math.Abs(dy) / math.Abs(g.prevDY)
+	}
+
+	ev := GestureEvent{
+		NumPointers:      len(g.pointers),
+		Kind:             GestureContinuous,
+		ZoomDelta:        zoom,
+		ZoomDelta2D:      Point{X: zoomX, Y: zoomY},
+		RotationDelta:    normalizeAngleDelta(angle - g.prevAngle),
+		TranslationDelta: Point{X: centroid.X - g.prevCentroid.X, Y: centroid.Y - g.prevCentroid.Y},
+		PinchType:        classifyPinchType(dx, dy),
+		Center:           centroid,
+		Timestamp:        ts,
+	}
+
+	g.prevDist, g.prevAngle, g.prevDX, g.prevDY, g.prevCentroid = dist, angle, dx, dy, centroid
+
+	if g.onGesture != nil {
+		g.onGesture(ev)
+	}
+}
+
+func (g *GestureRecognizer) emitSingle(kind GestureKind, pos Point, ts time.Duration) {
+	if g.onGesture == nil {
+		return
+	}
+	g.onGesture(GestureEvent{NumPointers: 1, Kind: kind, Center: pos, Timestamp: ts})
+}
+
+// twoFingers returns the two longest-tracked active pointers, in the order
+// they began, so a third+ simultaneous pointer doesn't perturb an
+// already-active pinch/rotate/pan gesture.
+func (g *GestureRecognizer) twoFingers() (a, b *gesturePointer, ok bool) {
+	found := 0
+	var ids [2]int
+	for _, id := range g.order {
+		if _, exists := g.pointers[id]; !exists {
+			continue
+		}
+		ids[found] = id
+		found++
+		if found == 2 {
+			break
+		}
+	}
+	if found < 2 {
+		return nil, nil, false
+	}
+	return g.pointers[ids[0]], g.pointers[ids[1]], true
+}
+
+// classifyPinchType classifies a two-pointer separation vector using the
+// same 3x-dominance heuristic as PinchType's doc comment describes.
+func classifyPinchType(dx, dy float64) PinchType {
+	absDx, absDy := math.Abs(dx), math.Abs(dy)
+	if absDx > absDy*3 {
+		return PinchHorizontal
+	}
+	if absDy > absDx*3 {
+		return PinchVertical
+	}
+	return PinchProportional
+}
+
+// Ensure GestureRecognizer implements GestureEventSource.
+var _ GestureEventSource = (*GestureRecognizer)(nil)
@@ -178,6 +178,186 @@ func TestPoint_Operations(t *testing.T) {
 	}
 }
 
+func TestGestureKind_String(t *testing.T) {
+	tests := []struct {
+		kind GestureKind
+		want string
+	}{
+		{GestureContinuous, "Continuous"},
+		{GestureTap, "Tap"},
+		{GestureDoubleTap, "DoubleTap"},
+		{GestureLongPress, "LongPress"},
+		{GestureKind(99), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := tt.kind.String()
+			if got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGestureRecognizer_TwoPointerZoomRotatePan(t *testing.T) {
+	g := NewGestureRecognizer()
+	var events []GestureEvent
+	g.OnGesture(func(ev GestureEvent) { events = append(events, ev) })
+
+	g.Begin(1, 0, 0, 0)
+	g.Begin(2, 10, 0, 0)
+
+	g.Move(1, -10, 0, 10*time.Millisecond)
+	g.Frame(10 * time.Millisecond)
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+
+	ev := events[0]
+	if ev.NumPointers != 2 {
+		t.Errorf("NumPointers: got %d, want 2", ev.NumPointers)
+	}
+	if ev.Kind != GestureContinuous {
+		t.Errorf("Kind: got %v, want GestureContinuous", ev.Kind)
+	}
+	if ev.ZoomDelta <= 1.0 {
+		t.Errorf("ZoomDelta: got %f, want >1.0 (fingers spread apart)", ev.ZoomDelta)
+	}
+	if ev.TranslationDelta.X >= 0 {
+		t.Errorf("TranslationDelta.X: got %f, want <0 (centroid moved left)", ev.TranslationDelta.X)
+	}
+}
+
+func TestGestureRecognizer_Tap(t *testing.T) {
+	g := NewGestureRecognizer()
+	var events []GestureEvent
+	g.OnGesture(func(ev GestureEvent) { events = append(events, ev) })
+
+	g.Begin(1, 5, 5, 0)
+	g.End(1, 5, 5, 50*time.Millisecond)
+
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+	if events[0].Kind != GestureTap {
+		t.Errorf("Kind: got %v, want GestureTap", events[0].Kind)
+	}
+	if events[0].NumPointers != 1 {
+		t.Errorf("NumPointers: got %d, want 1", events[0].NumPointers)
+	}
+}
+
+func TestGestureRecognizer_DoubleTap(t *testing.T) {
+	g := NewGestureRecognizer()
+	var kinds []GestureKind
+	g.OnGesture(func(ev GestureEvent) { kinds = append(kinds, ev.Kind) })
+
+	g.Begin(1, 5, 5, 0)
+	g.End(1, 5, 5, 50*time.Millisecond)
+	g.Begin(1, 6, 6, 100*time.Millisecond)
+	g.End(1, 6, 6, 150*time.Millisecond)
+
+	want := []GestureKind{GestureTap, GestureDoubleTap}
+	if len(kinds) != len(want) {
+		t.Fatalf("got %v, want %v", kinds, want)
+	}
+	for i := range want {
+		if kinds[i] != want[i] {
+			t.Errorf("kinds[%d] = %v, want %v", i, kinds[i], want[i])
+		}
+	}
+}
+
+func TestGestureRecognizer_LongPress(t *testing.T) {
+	g := NewGestureRecognizer(WithLongPressDuration(100 * time.Millisecond))
+	var kinds []GestureKind
+	g.OnGesture(func(ev GestureEvent) { kinds = append(kinds, ev.Kind) })
+
+	g.Begin(1, 5, 5, 0)
+	g.Tick(50 * time.Millisecond)
+	g.Tick(150 * time.Millisecond)
+	g.End(1, 5, 5, 200*time.Millisecond)
+
+	if len(kinds) != 1 || kinds[0] != GestureLongPress {
+		t.Fatalf("got %v, want [GestureLongPress]", kinds)
+	}
+}
+
+func TestGestureRecognizer_MoveBeyondSlopCancelsTapAndLongPress(t *testing.T) {
+	g := NewGestureRecognizer(WithSlopRadius(10), WithLongPressDuration(50*time.Millisecond))
+	var events []GestureEvent
+	g.OnGesture(func(ev GestureEvent) { events = append(events, ev) })
+
+	g.Begin(1, 0, 0, 0)
+	g.Move(1, 100, 0, 10*time.Millisecond)
+	g.Tick(60 * time.Millisecond)
+	g.End(1, 100, 0, 70*time.Millisecond)
+
+	if len(events) != 0 {
+		t.Errorf("got %d events, want 0 (gesture exceeded slop radius)", len(events))
+	}
+}
+
+func TestGestureRecognizer_ThirdPointerIgnoredByContinuousGesture(t *testing.T) {
+	g := NewGestureRecognizer()
+	var events []GestureEvent
+	g.OnGesture(func(ev GestureEvent) { events = append(events, ev) })
+
+	g.Begin(1, 0, 0, 0)
+	g.Begin(2, 10, 0, 0)
+	g.Begin(3, 20, 20, 0)
+	g.Move(1, -10, 0, 10*time.Millisecond)
+	g.Frame(10 * time.Millisecond)
+
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+	if events[0].NumPointers != 3 {
+		t.Errorf("NumPointers: got %d, want 3", events[0].NumPointers)
+	}
+}
+
+func TestGestureRecognizer_ImplementsGestureEventSource(t *testing.T) {
+	var _ GestureEventSource = NewGestureRecognizer()
+}
+
+func TestNewGestureRecognizerFromPointerSource(t *testing.T) {
+	src := &mockPointerEventSource{}
+	g := NewGestureRecognizerFromPointerSource(src)
+
+	var events []GestureEvent
+	g.OnGesture(func(ev GestureEvent) { events = append(events, ev) })
+
+	src.handler(PointerEvent{Kind: PointerDown, PointerID: 1, X: 0, Y: 0})
+	src.handler(PointerEvent{Kind: PointerDown, PointerID: 2, X: 10, Y: 0})
+	src.handler(PointerEvent{Kind: PointerMove, PointerID: 1, X: -10, Y: 0, Timestamp: 10 * time.Millisecond})
+	g.Frame(10 * time.Millisecond)
+
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+	if events[0].Kind != GestureContinuous || events[0].NumPointers != 2 {
+		t.Errorf("got %+v, want a 2-pointer GestureContinuous event", events[0])
+	}
+
+	src.handler(PointerEvent{Kind: PointerUp, PointerID: 1, X: -10, Y: 0})
+	src.handler(PointerEvent{Kind: PointerCancel, PointerID: 2})
+}
+
+func TestGestureRecognizer_Frame(t *testing.T) {
+	g := NewGestureRecognizer(WithLongPressDuration(50 * time.Millisecond))
+	var kinds []GestureKind
+	g.OnGesture(func(ev GestureEvent) { kinds = append(kinds, ev.Kind) })
+
+	g.Begin(1, 0, 0, 0)
+	g.Frame(60 * time.Millisecond)
+
+	if len(kinds) != 1 || kinds[0] != GestureLongPress {
+		t.Fatalf("got %v, want [GestureLongPress]", kinds)
+	}
+}
+
 func TestNullGestureEventSource(t *testing.T) {
 	var source NullGestureEventSource
 
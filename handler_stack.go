@@ -0,0 +1,185 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "sync"
+
+// Priority determines dispatch order within a HandlerStack. Handlers at a
+// higher Priority are offered an event before handlers at a lower one.
+type Priority int
+
+const (
+	// PriorityDefault is the priority used by a view's own default
+	// handling, dispatched last.
+	PriorityDefault Priority = iota
+
+	// PriorityWidget is used by individual widgets (buttons, text fields)
+	// that want first refusal on events within their bounds.
+	PriorityWidget
+
+	// PriorityModal is used by modal dialogs and popups that must
+	// intercept events (e.g. Escape to dismiss) before the underlying
+	// view sees them.
+	PriorityModal
+
+	// PrioritySystem is the highest priority, reserved for system-level
+	// concerns like global keyboard shortcuts or IME composition that must
+	// never be shadowed by application UI.
+	PrioritySystem
+)
+
+// HandlerID identifies a handler pushed onto a HandlerStack, for later
+// removal via HandlerStack.Remove.
+type HandlerID uint64
+
+// HandlerStack is a priority-sorted stack of handlers for a single event
+// type E. Dispatch walks handlers from highest to lowest priority (and, at
+// equal priority, in the order they were pushed) until one returns true,
+// meaning it consumed the event and propagation should stop.
+//
+// This lets several independent layers (a modal dialog, a focused widget,
+// the default view) subscribe to the same EventSource event without
+// fighting over a single callback slot:
+//
+//	modalEscape := stack.Push(PriorityModal, func(ev KeyEvent) bool {
+//	    if ev.PhysicalKey == KeyEscape {
+//	        dismissModal()
+//	        return true // consumed; the canvas below never sees it
+//	    }
+//	    return false
+//	})
+//
+// HandlerStack is safe for concurrent use.
+type HandlerStack[E any] struct {
+	mu       sync.Mutex
+	nextID   HandlerID
+	handlers []handlerEntry[E]
+}
+
+type handlerEntry[E any] struct {
+	id       HandlerID
+	priority Priority
+	fn       func(E) bool
+}
+
+// NewHandlerStack creates an empty HandlerStack for event type E.
+func NewHandlerStack[E any]() *HandlerStack[E] {
+	return &HandlerStack[E]{}
+}
+
+// Push inserts fn into the stack at priority and returns a HandlerID that
+// can later be passed to Remove. Among handlers at the same priority,
+// earlier pushes are offered the event first.
+func (s *HandlerStack[E]) Push(priority Priority, fn func(E) bool) HandlerID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	id := s.nextID
+	entry := handlerEntry[E]{id: id, priority: priority, fn: fn}
+
+	// Insert before the first handler with strictly lower priority, which
+	// keeps the slice sorted highest-to-lowest while preserving FIFO order
+	// among equal priorities.
+	idx := len(s.handlers)
+	for i, h := range s.handlers {
+		if h.priority < priority {
+			idx = i
+			break
+		}
+	}
+	s.handlers = append(s.handlers, handlerEntry[E]{})
+	copy(s.handlers[idx+1:], s.handlers[idx:])
+	s.handlers[idx] = entry
+
+	return id
+}
+
+// Remove unregisters the handler with the given ID. Removing an ID that
+// doesn't exist (already removed, or never pushed) is a no-op. Remove may
+// safely be called from within a handler that is itself being dispatched;
+// Dispatch iterates over a snapshot taken at the start of each call.
+func (s *HandlerStack[E]) Remove(id HandlerID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, h := range s.handlers {
+		if h.id == id {
+			s.handlers = append(s.handlers[:i], s.handlers[i+1:]...)
+			return
+		}
+	}
+}
+
+// Dispatch offers ev to each handler from highest to lowest priority until
+// one returns true. It returns true if some handler consumed the event,
+// false if every handler let it fall through.
+//
+// Dispatch takes a snapshot of the current handlers before iterating, so a
+// handler that calls Push or Remove during dispatch only affects future
+// Dispatch calls, never the one in progress.
+func (s *HandlerStack[E]) Dispatch(ev E) bool {
+	s.mu.Lock()
+	snapshot := make([]handlerEntry[E], len(s.handlers))
+	copy(snapshot, s.handlers)
+	s.mu.Unlock()
+
+	for _, h := range snapshot {
+		if h.fn(ev) {
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of handlers currently registered.
+func (s *HandlerStack[E]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.handlers)
+}
+
+// PrioritizedEventSource wraps a plain EventSource so that widgets,
+// modals, and IME layers can subscribe to key events at different
+// priorities instead of fighting over EventSource.OnKey's single callback
+// slot.
+//
+// Example:
+//
+//	pes := gpucontext.NewPrioritizedEventSource(source)
+//	pes.OnKey(gpucontext.PriorityModal, func(ev gpucontext.KeyEvent) bool {
+//	    if ev.PhysicalKey == gpucontext.KeyEscape {
+//	        dismissModal()
+//	        return true // stops the canvas' default handler from firing
+//	    }
+//	    return false
+//	})
+type PrioritizedEventSource struct {
+	src  EventSource
+	keys *HandlerStack[KeyEvent]
+}
+
+// NewPrioritizedEventSource registers a single OnKey callback on src that
+// fans out to a HandlerStack, and returns the wrapper used to push/remove
+// layered handlers.
+func NewPrioritizedEventSource(src EventSource) *PrioritizedEventSource {
+	p := &PrioritizedEventSource{
+		src:  src,
+		keys: NewHandlerStack[KeyEvent](),
+	}
+	src.OnKey(func(ev KeyEvent) { p.keys.Dispatch(ev) })
+	return p
+}
+
+// OnKey pushes fn onto the key-event handler stack at priority. fn should
+// return true to consume the event (stopping lower-priority handlers from
+// seeing it) or false to let it fall through.
+func (p *PrioritizedEventSource) OnKey(priority Priority, fn func(KeyEvent) bool) HandlerID {
+	return p.keys.Push(priority, fn)
+}
+
+// RemoveKeyHandler unregisters a handler previously returned by OnKey.
+func (p *PrioritizedEventSource) RemoveKeyHandler(id HandlerID) {
+	p.keys.Remove(id)
+}
@@ -0,0 +1,203 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "testing"
+
+func TestHandlerStack_PriorityOrdering(t *testing.T) {
+	stack := NewHandlerStack[int]()
+	var order []string
+
+	stack.Push(PriorityDefault, func(int) bool {
+		order = append(order, "default")
+		return false
+	})
+	stack.Push(PrioritySystem, func(int) bool {
+		order = append(order, "system")
+		return false
+	})
+	stack.Push(PriorityWidget, func(int) bool {
+		order = append(order, "widget")
+		return false
+	})
+	stack.Push(PriorityModal, func(int) bool {
+		order = append(order, "modal")
+		return false
+	})
+
+	stack.Dispatch(0)
+
+	want := []string{"system", "modal", "widget", "default"}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("order[%d] = %q, want %q (full: %v)", i, order[i], want[i], order)
+		}
+	}
+}
+
+func TestHandlerStack_EqualPriorityIsFIFO(t *testing.T) {
+	stack := NewHandlerStack[int]()
+	var order []int
+
+	stack.Push(PriorityWidget, func(int) bool { order = append(order, 1); return false })
+	stack.Push(PriorityWidget, func(int) bool { order = append(order, 2); return false })
+	stack.Push(PriorityWidget, func(int) bool { order = append(order, 3); return false })
+
+	stack.Dispatch(0)
+
+	want := []int{1, 2, 3}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("order = %v, want %v", order, want)
+			break
+		}
+	}
+}
+
+func TestHandlerStack_ConsumptionStopsPropagation(t *testing.T) {
+	stack := NewHandlerStack[int]()
+	lowerCalled := false
+
+	stack.Push(PriorityModal, func(int) bool { return true })
+	stack.Push(PriorityDefault, func(int) bool {
+		lowerCalled = true
+		return false
+	})
+
+	if consumed := stack.Dispatch(0); !consumed {
+		t.Error("Dispatch should report the event as consumed")
+	}
+	if lowerCalled {
+		t.Error("lower-priority handler should not be called once a higher one consumes the event")
+	}
+}
+
+func TestHandlerStack_Dispatch_NoConsumer(t *testing.T) {
+	stack := NewHandlerStack[int]()
+	stack.Push(PriorityDefault, func(int) bool { return false })
+
+	if stack.Dispatch(0) {
+		t.Error("Dispatch should report false when no handler consumes the event")
+	}
+}
+
+func TestHandlerStack_Remove(t *testing.T) {
+	stack := NewHandlerStack[int]()
+	called := false
+
+	id := stack.Push(PriorityDefault, func(int) bool {
+		called = true
+		return false
+	})
+	stack.Remove(id)
+	stack.Dispatch(0)
+
+	if called {
+		t.Error("removed handler should not be called")
+	}
+	if stack.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", stack.Len())
+	}
+
+	// Removing an unknown/already-removed ID is a no-op.
+	stack.Remove(id)
+}
+
+func TestHandlerStack_RemoveMidDispatch(t *testing.T) {
+	stack := NewHandlerStack[int]()
+	var secondID HandlerID
+	secondCalled := false
+
+	stack.Push(PriorityModal, func(int) bool {
+		// Removing another handler while dispatch is in progress must not
+		// affect the snapshot already being walked.
+		stack.Remove(secondID)
+		return false
+	})
+	secondID = stack.Push(PriorityWidget, func(int) bool {
+		secondCalled = true
+		return false
+	})
+
+	stack.Dispatch(0)
+
+	if !secondCalled {
+		t.Error("handler removed mid-dispatch should still run for the in-progress Dispatch call")
+	}
+
+	// But the removal must have taken effect for subsequent dispatches.
+	secondCalled = false
+	stack.Dispatch(0)
+	if secondCalled {
+		t.Error("handler should no longer run once removed")
+	}
+}
+
+func TestHandlerStack_PushDuringDispatch(t *testing.T) {
+	stack := NewHandlerStack[int]()
+	pushedCalled := false
+
+	stack.Push(PriorityDefault, func(int) bool {
+		stack.Push(PriorityDefault, func(int) bool {
+			pushedCalled = true
+			return false
+		})
+		return false
+	})
+
+	stack.Dispatch(0)
+	if pushedCalled {
+		t.Error("handler pushed during dispatch should not run for the in-progress Dispatch call")
+	}
+
+	pushedCalled = false
+	stack.Dispatch(0)
+	if !pushedCalled {
+		t.Error("handler pushed during the previous dispatch should run on the next Dispatch call")
+	}
+}
+
+func TestPrioritizedEventSource_ModalConsumesEscape(t *testing.T) {
+	src := &fakeEventSource{}
+	pes := NewPrioritizedEventSource(src)
+
+	canvasCalled := false
+	pes.OnKey(PriorityDefault, func(ev KeyEvent) bool {
+		canvasCalled = true
+		return false
+	})
+	pes.OnKey(PriorityModal, func(ev KeyEvent) bool {
+		return ev.PhysicalKey == KeyEscape
+	})
+
+	src.onKey(KeyEvent{PhysicalKey: KeyEscape})
+	if canvasCalled {
+		t.Error("modal handler should have consumed Escape before the default handler ran")
+	}
+
+	src.onKey(KeyEvent{PhysicalKey: KeyA})
+	if !canvasCalled {
+		t.Error("default handler should run for keys the modal doesn't consume")
+	}
+}
+
+func TestPrioritizedEventSource_RemoveKeyHandler(t *testing.T) {
+	src := &fakeEventSource{}
+	pes := NewPrioritizedEventSource(src)
+
+	called := false
+	id := pes.OnKey(PriorityModal, func(KeyEvent) bool {
+		called = true
+		return true
+	})
+	pes.RemoveKeyHandler(id)
+
+	src.onKey(KeyEvent{PhysicalKey: KeyEscape})
+	if called {
+		t.Error("removed handler should not be invoked")
+	}
+}
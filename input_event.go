@@ -0,0 +1,125 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+// InputKind discriminates the concrete type behind an InputEvent, mirroring
+// Gecko's InputData hierarchy (MOUSE_INPUT, MULTITOUCH_INPUT,
+// SCROLLWHEEL_INPUT, KEYBOARD_INPUT, and so on).
+type InputKind uint8
+
+const (
+	// InputKindPointer indicates the InputEvent is a PointerEvent.
+	InputKindPointer InputKind = iota
+
+	// InputKindScroll indicates the InputEvent is a ScrollEvent.
+	InputKindScroll
+
+	// InputKindGesture indicates the InputEvent is a GestureEvent.
+	InputKindGesture
+
+	// InputKindKey indicates the InputEvent is a KeyEvent.
+	InputKindKey
+)
+
+// String returns the input kind name for debugging.
+func (k InputKind) String() string {
+	switch k {
+	case InputKindPointer:
+		return "Pointer"
+	case InputKindScroll:
+		return "Scroll"
+	case InputKindGesture:
+		return "Gesture"
+	case InputKindKey:
+		return "Key"
+	default:
+		return "Unknown"
+	}
+}
+
+// InputEvent is implemented by every concrete input event type -
+// PointerEvent, ScrollEvent, GestureEvent, and KeyEvent - so middleware
+// that doesn't care about the specifics (recorders, replayers, remote-input
+// bridges, test harnesses) can consume all input through one callback
+// instead of registering a separate typed handler per event kind. Use
+// InputType to discriminate and the As*Event functions to recover the
+// concrete value.
+type InputEvent interface {
+	// InputType reports which concrete event type this value holds.
+	InputType() InputKind
+}
+
+// InputType reports InputKindPointer.
+func (ev PointerEvent) InputType() InputKind { return InputKindPointer }
+
+// InputType reports InputKindScroll.
+func (ev ScrollEvent) InputType() InputKind { return InputKindScroll }
+
+// InputType reports InputKindGesture.
+func (ev GestureEvent) InputType() InputKind { return InputKindGesture }
+
+// InputType reports InputKindKey.
+func (ev KeyEvent) InputType() InputKind { return InputKindKey }
+
+// Ensure the concrete event types implement InputEvent.
+var (
+	_ InputEvent = PointerEvent{}
+	_ InputEvent = ScrollEvent{}
+	_ InputEvent = GestureEvent{}
+	_ InputEvent = KeyEvent{}
+)
+
+// AsPointerEvent recovers the PointerEvent behind ev, if any.
+func AsPointerEvent(ev InputEvent) (PointerEvent, bool) {
+	p, ok := ev.(PointerEvent)
+	return p, ok
+}
+
+// AsScrollEvent recovers the ScrollEvent behind ev, if any.
+func AsScrollEvent(ev InputEvent) (ScrollEvent, bool) {
+	s, ok := ev.(ScrollEvent)
+	return s, ok
+}
+
+// AsGestureEvent recovers the GestureEvent behind ev, if any.
+func AsGestureEvent(ev InputEvent) (GestureEvent, bool) {
+	g, ok := ev.(GestureEvent)
+	return g, ok
+}
+
+// AsKeyEvent recovers the KeyEvent behind ev, if any.
+func AsKeyEvent(ev InputEvent) (KeyEvent, bool) {
+	k, ok := ev.(KeyEvent)
+	return k, ok
+}
+
+// InputEventSource is an optional capability of platforms that can
+// dispatch every input event through one uniform callback, rather than
+// requiring a separate typed registration per event kind.
+//
+// InputEventSource is optional. Use type assertion to check availability:
+//
+//	if ies, ok := eventSource.(gpucontext.InputEventSource); ok {
+//	    ies.OnInput(handleAnyInput)
+//	}
+type InputEventSource interface {
+	// OnInput registers a callback invoked for every input event,
+	// regardless of kind. Use InputEvent.InputType to discriminate and
+	// an As*Event function to recover the concrete value.
+	//
+	// Callback threading: Called on the main/UI thread.
+	// Callbacks should be fast and non-blocking.
+	OnInput(fn func(InputEvent))
+}
+
+// NullInputEventSource implements InputEventSource by ignoring all
+// registrations. Useful for platforms or configurations where unified
+// input dispatch is not available.
+type NullInputEventSource struct{}
+
+// OnInput does nothing.
+func (NullInputEventSource) OnInput(func(InputEvent)) {}
+
+// Ensure NullInputEventSource implements InputEventSource.
+var _ InputEventSource = NullInputEventSource{}
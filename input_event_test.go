@@ -0,0 +1,92 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "testing"
+
+func TestInputKind_String(t *testing.T) {
+	tests := []struct {
+		kind InputKind
+		want string
+	}{
+		{InputKindPointer, "Pointer"},
+		{InputKindScroll, "Scroll"},
+		{InputKindGesture, "Gesture"},
+		{InputKindKey, "Key"},
+		{InputKind(99), "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.kind.String(); got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInputEvent_InputType(t *testing.T) {
+	var events = []InputEvent{
+		PointerEvent{},
+		ScrollEvent{},
+		GestureEvent{},
+		KeyEvent{},
+	}
+	want := []InputKind{InputKindPointer, InputKindScroll, InputKindGesture, InputKindKey}
+
+	for i, ev := range events {
+		if got := ev.InputType(); got != want[i] {
+			t.Errorf("events[%d].InputType(): got %v, want %v", i, got, want[i])
+		}
+	}
+}
+
+func TestAsEvent_Accessors(t *testing.T) {
+	t.Run("AsPointerEvent matches", func(t *testing.T) {
+		ev := InputEvent(PointerEvent{X: 1})
+		p, ok := AsPointerEvent(ev)
+		if !ok || p.X != 1 {
+			t.Errorf("AsPointerEvent(): got (%+v, %v)", p, ok)
+		}
+		if _, ok := AsScrollEvent(ev); ok {
+			t.Error("AsScrollEvent() should not match a PointerEvent")
+		}
+	})
+
+	t.Run("AsScrollEvent matches", func(t *testing.T) {
+		ev := InputEvent(ScrollEvent{DeltaY: 2})
+		s, ok := AsScrollEvent(ev)
+		if !ok || s.DeltaY != 2 {
+			t.Errorf("AsScrollEvent(): got (%+v, %v)", s, ok)
+		}
+	})
+
+	t.Run("AsGestureEvent matches", func(t *testing.T) {
+		ev := InputEvent(GestureEvent{NumPointers: 2})
+		g, ok := AsGestureEvent(ev)
+		if !ok || g.NumPointers != 2 {
+			t.Errorf("AsGestureEvent(): got (%+v, %v)", g, ok)
+		}
+	})
+
+	t.Run("AsKeyEvent matches", func(t *testing.T) {
+		ev := InputEvent(KeyEvent{Pressed: true})
+		k, ok := AsKeyEvent(ev)
+		if !ok || !k.Pressed {
+			t.Errorf("AsKeyEvent(): got (%+v, %v)", k, ok)
+		}
+	})
+}
+
+func TestNullInputEventSource(t *testing.T) {
+	var s NullInputEventSource
+
+	called := false
+	s.OnInput(func(InputEvent) { called = true })
+	if called {
+		t.Error("NullInputEventSource should not call the callback")
+	}
+
+	var _ InputEventSource = NullInputEventSource{}
+	var _ InputEventSource = s
+}
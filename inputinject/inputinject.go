@@ -0,0 +1,267 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+// Package inputinject provides synthetic input injection for integration
+// tests and automation, modelled on Fuchsia's input-synthesis
+// InputDeviceRegistry. It lets a test drive a gpucontext event pipeline
+// (pointer, touch, keyboard) without a real display or input hardware.
+package inputinject
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/gogpu/gpucontext"
+)
+
+// Point is a 2D coordinate in logical pixels, used by the gesture helpers.
+type Point struct {
+	X, Y float64
+}
+
+// Injector injects synthetic input events into an event pipeline.
+//
+// Implementations:
+//   - InjectableSource feeds injected events straight to registered callbacks
+//   - RecordingInjector wraps another Injector and records a replayable trace
+type Injector interface {
+	// InjectPointer delivers a synthetic pointer event.
+	InjectPointer(ev gpucontext.PointerEvent)
+
+	// InjectTouch delivers a synthetic touch event.
+	InjectTouch(ev gpucontext.TouchEvent)
+
+	// InjectKey delivers a synthetic key event.
+	InjectKey(ev gpucontext.KeyEvent)
+}
+
+// Tap injects a single primary-button press and release at (x, y).
+func Tap(inj Injector, x, y float64) {
+	inj.InjectPointer(gpucontext.PointerEvent{
+		Kind: gpucontext.PointerDown, X: x, Y: y,
+		Button: gpucontext.ButtonLeft, Buttons: gpucontext.ButtonsLeft, IsPrimary: true,
+	})
+	inj.InjectPointer(gpucontext.PointerEvent{
+		Kind: gpucontext.PointerUp, X: x, Y: y,
+		Button: gpucontext.ButtonLeft, IsPrimary: true,
+	})
+}
+
+// Swipe injects a press at from, steps intermediate moves interpolating
+// linearly to, and a release, spread evenly over duration.
+func Swipe(inj Injector, from, to Point, duration time.Duration, steps int) {
+	if steps < 1 {
+		steps = 1
+	}
+
+	inj.InjectPointer(gpucontext.PointerEvent{
+		Kind: gpucontext.PointerDown, X: from.X, Y: from.Y,
+		Button: gpucontext.ButtonLeft, Buttons: gpucontext.ButtonsLeft, IsPrimary: true,
+	})
+
+	for i := 1; i <= steps; i++ {
+		t := float64(i) / float64(steps)
+		inj.InjectPointer(gpucontext.PointerEvent{
+			Kind:      gpucontext.PointerMove,
+			X:         from.X + (to.X-from.X)*t,
+			Y:         from.Y + (to.Y-from.Y)*t,
+			Buttons:   gpucontext.ButtonsLeft,
+			IsPrimary: true,
+			Timestamp: time.Duration(float64(duration) * t),
+		})
+	}
+
+	inj.InjectPointer(gpucontext.PointerEvent{
+		Kind: gpucontext.PointerUp, X: to.X, Y: to.Y,
+		Button: gpucontext.ButtonLeft, IsPrimary: true, Timestamp: duration,
+	})
+}
+
+// Pinch injects a two-finger pinch/spread gesture centered at center, with
+// the fingers starting startDist apart (along the X axis) and ending
+// endDist apart, spread evenly over duration.
+func Pinch(inj Injector, center Point, startDist, endDist float64, duration time.Duration) {
+	const steps = 10
+	const idA, idB = gpucontext.TouchID(1), gpucontext.TouchID(2)
+
+	fingers := func(dist float64) []gpucontext.TouchPoint {
+		return []gpucontext.TouchPoint{
+			{ID: idA, X: center.X - dist/2, Y: center.Y},
+			{ID: idB, X: center.X + dist/2, Y: center.Y},
+		}
+	}
+
+	began := fingers(startDist)
+	inj.InjectTouch(gpucontext.TouchEvent{Phase: gpucontext.TouchBegan, Changed: began, All: began})
+
+	for i := 1; i <= steps; i++ {
+		t := float64(i) / steps
+		all := fingers(startDist + (endDist-startDist)*t)
+		inj.InjectTouch(gpucontext.TouchEvent{
+			Phase: gpucontext.TouchMoved, Changed: all, All: all,
+			Timestamp: time.Duration(float64(duration) * t),
+		})
+	}
+
+	ended := fingers(endDist)
+	inj.InjectTouch(gpucontext.TouchEvent{Phase: gpucontext.TouchEnded, Changed: ended, Timestamp: duration})
+}
+
+// TypeText injects a press/release KeyEvent pair for each rune in s, with
+// Text set to the rune so consumers that only look at KeyEvent.Text (rather
+// than PhysicalKey/LogicalKey) still see the typed characters.
+func TypeText(inj Injector, s string) {
+	for _, r := range s {
+		text := string(r)
+		inj.InjectKey(gpucontext.KeyEvent{Text: text, Pressed: true})
+		inj.InjectKey(gpucontext.KeyEvent{Text: text, Pressed: false})
+	}
+}
+
+// recordedEvent is one entry in a RecordingInjector trace. Exactly one of
+// Pointer, Touch, or Key is set, matching the Injector method that produced it.
+type recordedEvent struct {
+	Pointer *gpucontext.PointerEvent `json:"pointer,omitempty"`
+	Touch   *gpucontext.TouchEvent   `json:"touch,omitempty"`
+	Key     *gpucontext.KeyEvent     `json:"key,omitempty"`
+}
+
+// RecordingInjector wraps another Injector and records every injected event
+// into a scriptable trace that can be saved to disk and replayed later.
+//
+// Target may be nil if the trace is being built for later replay without
+// also driving a live pipeline.
+type RecordingInjector struct {
+	target Injector
+	trace  []recordedEvent
+}
+
+// NewRecordingInjector creates a RecordingInjector that forwards every
+// injected event to target (if non-nil) after recording it.
+func NewRecordingInjector(target Injector) *RecordingInjector {
+	return &RecordingInjector{target: target}
+}
+
+// InjectPointer records ev and forwards it to the target Injector, if any.
+func (r *RecordingInjector) InjectPointer(ev gpucontext.PointerEvent) {
+	r.trace = append(r.trace, recordedEvent{Pointer: &ev})
+	if r.target != nil {
+		r.target.InjectPointer(ev)
+	}
+}
+
+// InjectTouch records ev and forwards it to the target Injector, if any.
+func (r *RecordingInjector) InjectTouch(ev gpucontext.TouchEvent) {
+	r.trace = append(r.trace, recordedEvent{Touch: &ev})
+	if r.target != nil {
+		r.target.InjectTouch(ev)
+	}
+}
+
+// InjectKey records ev and forwards it to the target Injector, if any.
+func (r *RecordingInjector) InjectKey(ev gpucontext.KeyEvent) {
+	r.trace = append(r.trace, recordedEvent{Key: &ev})
+	if r.target != nil {
+		r.target.InjectKey(ev)
+	}
+}
+
+// Len returns the number of events recorded so far.
+func (r *RecordingInjector) Len() int { return len(r.trace) }
+
+// Save writes the recorded trace to path as JSON, for replay with Load.
+func (r *RecordingInjector) Save(path string) error {
+	data, err := json.MarshalIndent(r.trace, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// Load reads a trace previously written by Save and replays it against inj.
+func Load(path string, inj Injector) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var trace []recordedEvent
+	if err := json.Unmarshal(data, &trace); err != nil {
+		return err
+	}
+	for _, ev := range trace {
+		switch {
+		case ev.Pointer != nil:
+			inj.InjectPointer(*ev.Pointer)
+		case ev.Touch != nil:
+			inj.InjectTouch(*ev.Touch)
+		case ev.Key != nil:
+			inj.InjectKey(*ev.Key)
+		}
+	}
+	return nil
+}
+
+// Ensure RecordingInjector implements Injector.
+var _ Injector = (*RecordingInjector)(nil)
+
+// InjectableSource is an EventSource, PointerEventSource, and
+// TouchEventSource whose events are driven entirely by Injector calls
+// rather than a real platform backend. It delegates every registration it
+// doesn't itself care about to the gpucontext Null* implementations, so
+// headless tests can drive the whole event pipeline without a display.
+//
+// Construct with NewInjectableSource.
+type InjectableSource struct {
+	gpucontext.NullEventSource
+	gpucontext.NullPointerEventSource
+	gpucontext.NullTouchEventSource
+
+	onPointer func(gpucontext.PointerEvent)
+	onTouch   func(gpucontext.TouchEvent)
+	onKey     func(gpucontext.KeyEvent)
+}
+
+// NewInjectableSource creates an InjectableSource ready to have its
+// registered callbacks driven via Inject*.
+func NewInjectableSource() *InjectableSource {
+	return &InjectableSource{}
+}
+
+// OnPointer registers fn to receive injected pointer events.
+func (s *InjectableSource) OnPointer(fn func(gpucontext.PointerEvent)) { s.onPointer = fn }
+
+// OnTouch registers fn to receive injected touch events.
+func (s *InjectableSource) OnTouch(fn func(gpucontext.TouchEvent)) { s.onTouch = fn }
+
+// OnKey registers fn to receive injected key events, overriding the
+// embedded NullEventSource's no-op OnKey.
+func (s *InjectableSource) OnKey(fn func(gpucontext.KeyEvent)) { s.onKey = fn }
+
+// InjectPointer delivers ev to the registered OnPointer callback, if any.
+func (s *InjectableSource) InjectPointer(ev gpucontext.PointerEvent) {
+	if s.onPointer != nil {
+		s.onPointer(ev)
+	}
+}
+
+// InjectTouch delivers ev to the registered OnTouch callback, if any.
+func (s *InjectableSource) InjectTouch(ev gpucontext.TouchEvent) {
+	if s.onTouch != nil {
+		s.onTouch(ev)
+	}
+}
+
+// InjectKey delivers ev to the registered OnKey callback, if any.
+func (s *InjectableSource) InjectKey(ev gpucontext.KeyEvent) {
+	if s.onKey != nil {
+		s.onKey(ev)
+	}
+}
+
+// Ensure InjectableSource implements EventSource, PointerEventSource,
+// TouchEventSource, and Injector.
+var _ gpucontext.EventSource = (*InjectableSource)(nil)
+var _ gpucontext.PointerEventSource = (*InjectableSource)(nil)
+var _ gpucontext.TouchEventSource = (*InjectableSource)(nil)
+var _ Injector = (*InjectableSource)(nil)
@@ -0,0 +1,147 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package inputinject
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gogpu/gpucontext"
+)
+
+func TestTap(t *testing.T) {
+	src := NewInjectableSource()
+	var kinds []gpucontext.PointerKind
+	src.OnPointer(func(ev gpucontext.PointerEvent) { kinds = append(kinds, ev.Kind) })
+
+	Tap(src, 10, 20)
+
+	want := []gpucontext.PointerKind{gpucontext.PointerDown, gpucontext.PointerUp}
+	if len(kinds) != len(want) {
+		t.Fatalf("got %d events, want %d", len(kinds), len(want))
+	}
+	for i := range want {
+		if kinds[i] != want[i] {
+			t.Errorf("kinds[%d] = %v, want %v", i, kinds[i], want[i])
+		}
+	}
+}
+
+func TestSwipe(t *testing.T) {
+	src := NewInjectableSource()
+	var events []gpucontext.PointerEvent
+	src.OnPointer(func(ev gpucontext.PointerEvent) { events = append(events, ev) })
+
+	Swipe(src, Point{0, 0}, Point{100, 0}, 100*time.Millisecond, 4)
+
+	// down + 4 moves + up = 6 events
+	if len(events) != 6 {
+		t.Fatalf("got %d events, want 6", len(events))
+	}
+	if events[0].Kind != gpucontext.PointerDown {
+		t.Errorf("first event = %v, want PointerDown", events[0].Kind)
+	}
+	if events[len(events)-1].Kind != gpucontext.PointerUp {
+		t.Errorf("last event = %v, want PointerUp", events[len(events)-1].Kind)
+	}
+	if events[len(events)-1].X != 100 {
+		t.Errorf("final X = %f, want 100", events[len(events)-1].X)
+	}
+}
+
+func TestPinch(t *testing.T) {
+	src := NewInjectableSource()
+	var phases []gpucontext.TouchPhase
+	src.OnTouch(func(ev gpucontext.TouchEvent) { phases = append(phases, ev.Phase) })
+
+	Pinch(src, Point{50, 50}, 10, 100, 50*time.Millisecond)
+
+	if phases[0] != gpucontext.TouchBegan {
+		t.Errorf("first phase = %v, want TouchBegan", phases[0])
+	}
+	if phases[len(phases)-1] != gpucontext.TouchEnded {
+		t.Errorf("last phase = %v, want TouchEnded", phases[len(phases)-1])
+	}
+}
+
+func TestTypeText(t *testing.T) {
+	src := NewInjectableSource()
+	var texts []string
+	src.OnKey(func(ev gpucontext.KeyEvent) {
+		if ev.Pressed {
+			texts = append(texts, ev.Text)
+		}
+	})
+
+	TypeText(src, "hi")
+
+	want := []string{"h", "i"}
+	if len(texts) != len(want) {
+		t.Fatalf("got %v, want %v", texts, want)
+	}
+	for i := range want {
+		if texts[i] != want[i] {
+			t.Errorf("texts[%d] = %q, want %q", i, texts[i], want[i])
+		}
+	}
+}
+
+func TestRecordingInjector_RecordsAndForwards(t *testing.T) {
+	src := NewInjectableSource()
+	var delivered int
+	src.OnPointer(func(gpucontext.PointerEvent) { delivered++ })
+
+	rec := NewRecordingInjector(src)
+	Tap(rec, 1, 2)
+
+	if rec.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", rec.Len())
+	}
+	if delivered != 2 {
+		t.Errorf("delivered = %d, want 2 (forwarded to target)", delivered)
+	}
+}
+
+func TestRecordingInjector_SaveAndLoad(t *testing.T) {
+	rec := NewRecordingInjector(nil)
+	Tap(rec, 5, 6)
+
+	path := filepath.Join(t.TempDir(), "trace.json")
+	if err := rec.Save(path); err != nil {
+		t.Fatalf("Save() err = %v", err)
+	}
+
+	replay := NewInjectableSource()
+	var replayed []gpucontext.PointerKind
+	replay.OnPointer(func(ev gpucontext.PointerEvent) { replayed = append(replayed, ev.Kind) })
+
+	if err := Load(path, replay); err != nil {
+		t.Fatalf("Load() err = %v", err)
+	}
+
+	want := []gpucontext.PointerKind{gpucontext.PointerDown, gpucontext.PointerUp}
+	if len(replayed) != len(want) {
+		t.Fatalf("got %d replayed events, want %d", len(replayed), len(want))
+	}
+	for i := range want {
+		if replayed[i] != want[i] {
+			t.Errorf("replayed[%d] = %v, want %v", i, replayed[i], want[i])
+		}
+	}
+}
+
+func TestNewInjectableSource_ImplementsInterfaces(t *testing.T) {
+	src := NewInjectableSource()
+
+	var _ gpucontext.EventSource = src
+	var _ gpucontext.PointerEventSource = src
+	var _ gpucontext.TouchEventSource = src
+	var _ Injector = src
+
+	// Registrations for methods InjectableSource doesn't override should
+	// still be safe no-ops via the embedded Null* implementations.
+	src.OnMouseMove(func(float64, float64) {})
+	src.OnResize(func(int, int) {})
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "time"
+
+// PanGesturePhase classifies a PanGestureEvent, following the
+// MayStart/Start/Pan/End/Cancelled phase model macOS and Wayland
+// compositors use to report native touchpad pan gestures.
+type PanGesturePhase uint8
+
+const (
+	// PanPhaseMayStart indicates the platform detected fingers on the
+	// touchpad that could become a pan, before any movement threshold is
+	// crossed. Not all platforms report this phase.
+	PanPhaseMayStart PanGesturePhase = iota
+
+	// PanPhaseStart indicates the pan gesture has begun.
+	PanPhaseStart
+
+	// PanPhasePan indicates an ongoing pan update, carrying the
+	// incremental delta since the previous event.
+	PanPhasePan
+
+	// PanPhaseEnd indicates the fingers were lifted, ending the gesture.
+	// Momentum is true if kinetic scrolling should continue afterward.
+	PanPhaseEnd
+
+	// PanPhaseCancelled indicates the platform aborted the gesture (for
+	// example, a system gesture took over). No momentum follows.
+	PanPhaseCancelled
+)
+
+// String returns the pan gesture phase name for debugging.
+func (p PanGesturePhase) String() string {
+	switch p {
+	case PanPhaseMayStart:
+		return "MayStart"
+	case PanPhaseStart:
+		return "Start"
+	case PanPhasePan:
+		return "Pan"
+	case PanPhaseEnd:
+		return "End"
+	case PanPhaseCancelled:
+		return "Cancelled"
+	default:
+		return "Unknown"
+	}
+}
+
+// PanGestureEvent reports a native touchpad pan gesture, as delivered
+// directly by the platform (macOS NSEvent phase, Wayland touchpad
+// gestures protocol) rather than reconstructed from raw pointer or touch
+// data. Unlike GestureEvent's centroid-based translation delta, PanGestureEvent
+// carries the platform's own momentum flag, so applications can
+// distinguish a deliberate two-finger drag from the kinetic coast that
+// follows it without implementing their own fling physics - compare
+// FlingEstimator, which is for platforms that don't report momentum
+// themselves.
+type PanGestureEvent struct {
+	// Phase classifies this event within the gesture's lifecycle.
+	Phase PanGesturePhase
+
+	// DeltaX and DeltaY are the incremental pan movement in logical
+	// pixels since the previous event. Zero for PanPhaseMayStart.
+	DeltaX float64
+	DeltaY float64
+
+	// VelocityX and VelocityY are the current panning velocity, in
+	// logical pixels per second.
+	VelocityX float64
+	VelocityY float64
+
+	// Momentum is true if this event is part of the kinetic coast the
+	// platform generates after the fingers lift, rather than live finger
+	// movement. Only meaningful from PanPhaseEnd onward.
+	Momentum bool
+
+	// Timestamp is the event time as duration since an arbitrary
+	// reference. Zero if timestamps are not available.
+	Timestamp time.Duration
+}
+
+// PanGestureEventSource is an optional capability of platforms that
+// report native touchpad pan gestures directly, rather than requiring
+// them to be reconstructed from PointerEvent/TouchEvent.
+//
+// PanGestureEventSource is optional. Use type assertion to check
+// availability:
+//
+//	if pgs, ok := eventSource.(gpucontext.PanGestureEventSource); ok {
+//	    pgs.OnPanGesture(handlePanGesture)
+//	}
+type PanGestureEventSource interface {
+	// OnPanGesture registers a callback for native touchpad pan gestures.
+	//
+	// Callback threading: Called on the main/UI thread.
+	// Callbacks should be fast and non-blocking.
+	OnPanGesture(fn func(PanGestureEvent))
+}
+
+// NullPanGestureEventSource implements PanGestureEventSource by ignoring
+// all registrations. Useful for platforms or configurations where native
+// pan gestures are not available.
+type NullPanGestureEventSource struct{}
+
+// OnPanGesture does nothing.
+func (NullPanGestureEventSource) OnPanGesture(func(PanGestureEvent)) {}
+
+// Ensure NullPanGestureEventSource implements PanGestureEventSource.
+var _ PanGestureEventSource = NullPanGestureEventSource{}
@@ -0,0 +1,40 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "testing"
+
+func TestPanGesturePhase_String(t *testing.T) {
+	tests := []struct {
+		phase PanGesturePhase
+		want  string
+	}{
+		{PanPhaseMayStart, "MayStart"},
+		{PanPhaseStart, "Start"},
+		{PanPhasePan, "Pan"},
+		{PanPhaseEnd, "End"},
+		{PanPhaseCancelled, "Cancelled"},
+		{PanGesturePhase(99), "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.phase.String(); got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullPanGestureEventSource(t *testing.T) {
+	var p NullPanGestureEventSource
+
+	called := false
+	p.OnPanGesture(func(PanGestureEvent) { called = true })
+	if called {
+		t.Error("NullPanGestureEventSource should not call the callback")
+	}
+
+	var _ PanGestureEventSource = NullPanGestureEventSource{}
+	var _ PanGestureEventSource = p
+}
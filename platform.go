@@ -3,6 +3,8 @@
 
 package gpucontext
 
+import "image"
+
 // PlatformProvider provides OS integration features.
 //
 // This interface enables UI frameworks (like gogpu/ui) to access platform
@@ -101,6 +103,10 @@ const (
 
 	// CursorNone hides the cursor.
 	CursorNone
+
+	// CursorGrab is the open/closed hand cursor used while panning or
+	// dragging content (e.g. a canvas, a map).
+	CursorGrab
 )
 
 // String returns the cursor shape name for debugging.
@@ -130,6 +136,8 @@ func (c CursorShape) String() string {
 		return "Wait"
 	case CursorNone:
 		return "None"
+	case CursorGrab:
+		return "Grab"
 	default:
 		return "Unknown"
 	}
@@ -169,5 +177,39 @@ func (NullPlatformProvider) HighContrast() bool { return false }
 // FontScale returns 1.0.
 func (NullPlatformProvider) FontScale() float32 { return 1.0 }
 
-// Ensure NullPlatformProvider implements PlatformProvider.
+// ClipboardFormats returns nil.
+func (NullPlatformProvider) ClipboardFormats() []ClipboardFormat { return nil }
+
+// ClipboardReadFormat returns nil and nil error.
+func (NullPlatformProvider) ClipboardReadFormat(ClipboardFormat) ([]byte, error) { return nil, nil }
+
+// ClipboardWriteItems does nothing and returns nil.
+func (NullPlatformProvider) ClipboardWriteItems([]ClipboardItem) error { return nil }
+
+// nullCursor is the no-op Cursor handle returned by NullPlatformProvider.
+type nullCursor struct{}
+
+// Release does nothing.
+func (nullCursor) Release() {}
+
+// Ensure nullCursor implements Cursor.
+var _ Cursor = nullCursor{}
+
+// CreateCursor returns a no-op Cursor and nil error.
+func (NullPlatformProvider) CreateCursor(image.Image, int, int) (Cursor, error) {
+	return nullCursor{}, nil
+}
+
+// CreateAnimatedCursor returns a no-op Cursor and nil error.
+func (NullPlatformProvider) CreateAnimatedCursor([]CursorFrame) (Cursor, error) {
+	return nullCursor{}, nil
+}
+
+// SetCursorHandle does nothing.
+func (NullPlatformProvider) SetCursorHandle(Cursor) {}
+
+// Ensure NullPlatformProvider implements PlatformProvider, ClipboardProvider,
+// and CursorFactory.
 var _ PlatformProvider = NullPlatformProvider{}
+var _ ClipboardProvider = NullPlatformProvider{}
+var _ CursorFactory = NullPlatformProvider{}
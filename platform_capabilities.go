@@ -0,0 +1,311 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import (
+	"context"
+	"image"
+	"time"
+)
+
+// Clipboard provides multi-format clipboard access.
+//
+// PlatformProvider already exposes plain-text clipboard access via
+// ClipboardRead/ClipboardWrite. Clipboard is an optional, richer capability
+// for implementations that can also read and write images and other
+// MIME-typed content. Use type assertion to check availability:
+//
+//	if cb, ok := provider.(gpucontext.Clipboard); ok {
+//	    img, _ := cb.ReadImage(ctx, "image/png")
+//	}
+type Clipboard interface {
+	// ReadText reads text content from the system clipboard.
+	// Returns empty string and nil error if the clipboard is empty or not text.
+	ReadText(ctx context.Context) (string, error)
+
+	// WriteText writes text content to the system clipboard.
+	WriteText(ctx context.Context, text string) error
+
+	// ReadImage reads image content from the system clipboard encoded as
+	// mimeType (e.g. "image/png"). Returns nil and nil error if the
+	// clipboard holds no image data in that format.
+	ReadImage(ctx context.Context, mimeType string) ([]byte, error)
+
+	// WriteImage writes image data to the system clipboard, encoded as
+	// mimeType (e.g. "image/png").
+	WriteImage(ctx context.Context, mimeType string, data []byte) error
+}
+
+// ClipboardFormat identifies a clipboard content format as a MIME type
+// (e.g. "text/plain;charset=utf-8", "text/html", "image/png"), mirroring
+// the format negotiation used by the web Clipboard API and modern desktop
+// pasteboards. Platform-specific formats with no MIME equivalent (legacy
+// CF_* atoms, custom NSPasteboardTypes, X11 selection target atoms) are
+// represented by their native name string.
+type ClipboardFormat string
+
+const (
+	// ClipboardFormatText is plain UTF-8 text.
+	ClipboardFormatText ClipboardFormat = "text/plain;charset=utf-8"
+
+	// ClipboardFormatHTML is rich text encoded as HTML fragment.
+	ClipboardFormatHTML ClipboardFormat = "text/html"
+
+	// ClipboardFormatPNG is image data encoded as PNG.
+	ClipboardFormatPNG ClipboardFormat = "image/png"
+
+	// ClipboardFormatURIList is a newline-separated list of file URIs,
+	// used for copying/dropping file references.
+	ClipboardFormatURIList ClipboardFormat = "text/uri-list"
+)
+
+// ClipboardItem is one entry written to or read from the clipboard via
+// ClipboardProvider, pairing raw bytes with the format they're encoded in.
+type ClipboardItem struct {
+	Format ClipboardFormat
+	Data   []byte
+}
+
+// ClipboardProvider extends PlatformProvider with typed, multi-format
+// clipboard access, for UI code that needs to paste images, rich text, or
+// file lists rather than plain text alone (the way Gio and Zed do).
+//
+// ClipboardProvider is optional. Use type assertion to check availability:
+//
+//	if cp, ok := provider.(gpucontext.ClipboardProvider); ok {
+//	    png, _ := cp.ClipboardReadFormat(gpucontext.ClipboardFormatPNG)
+//	}
+//
+// Implementations should treat PlatformProvider's ClipboardRead/
+// ClipboardWrite as convenience wrappers around this API: ClipboardRead is
+// equivalent to ClipboardReadFormat(ClipboardFormatText), and
+// ClipboardWrite is equivalent to ClipboardWriteItems with a single
+// ClipboardFormatText item.
+//
+// See also Clipboard, above, for a context-aware variant of multi-format
+// clipboard access built around explicit text/image methods rather than
+// MIME-typed items; the two are independent optional capabilities and an
+// implementation may satisfy either, both, or neither.
+type ClipboardProvider interface {
+	// ClipboardFormats returns the formats currently available on the
+	// system clipboard, for deciding which ClipboardReadFormat call to
+	// make. Returns nil if the clipboard is empty.
+	ClipboardFormats() []ClipboardFormat
+
+	// ClipboardReadFormat reads clipboard content in the given format.
+	// Returns nil and nil error if the clipboard holds no data in that
+	// format.
+	ClipboardReadFormat(format ClipboardFormat) ([]byte, error)
+
+	// ClipboardWriteItems replaces the system clipboard contents with
+	// items, typically offering each format to the system simultaneously
+	// so the paste target can pick the richest one it understands.
+	ClipboardWriteItems(items []ClipboardItem) error
+}
+
+// CursorController provides cursor shape and visibility control beyond the
+// fixed CursorShape palette exposed by PlatformProvider.SetCursor.
+//
+// CursorController is optional. Use type assertion to check availability:
+//
+//	if cc, ok := provider.(gpucontext.CursorController); ok {
+//	    cc.SetCustomCursor(img, 0, 0)
+//	}
+type CursorController interface {
+	// SetCursor changes the mouse cursor shape.
+	SetCursor(cursor CursorShape)
+
+	// SetCursorVisible shows or hides the cursor entirely.
+	SetCursorVisible(visible bool)
+
+	// SetCustomCursor sets the cursor to a custom image, with the hotspot
+	// (the pixel that tracks the pointer position) at (hotspotX, hotspotY)
+	// in image pixel coordinates.
+	SetCustomCursor(img image.Image, hotspotX, hotspotY int)
+}
+
+// CursorFrame is one frame of an animated cursor, paired with how long it
+// should be displayed before advancing to the next frame.
+type CursorFrame struct {
+	Image    image.Image
+	Duration time.Duration
+}
+
+// Cursor is an opaque, backend-owned cursor handle created by
+// CursorFactory.CreateCursor/CreateAnimatedCursor. Each backend wraps its
+// native handle (Win32 HCURSOR, AppKit NSCursor, X11 Cursor) behind this
+// interface.
+type Cursor interface {
+	// Release frees the underlying platform cursor resource. Cursors are
+	// not garbage collected automatically; callers (typically
+	// CursorCache) must call Release when a cursor is no longer needed.
+	Release()
+}
+
+// CursorFactory extends PlatformProvider with app-defined cursor images,
+// following the pattern in GLFW's glfwCreateCursor and WindowMaker's
+// configurable cursor path.
+//
+// Unlike CursorController.SetCustomCursor, which uploads an image and
+// applies it in a single call, CursorFactory separates creation from
+// application: CreateCursor/CreateAnimatedCursor upload once and return a
+// reusable handle, and SetCursorHandle applies it. Since cursor
+// application typically happens every frame (see PlatformProvider.
+// SetCursor's docstring), pairing CursorFactory with CursorCache avoids
+// re-uploading the same bitmap dozens of times per second.
+//
+// CursorFactory is optional. Use type assertion to check availability:
+//
+//	if cf, ok := provider.(gpucontext.CursorFactory); ok {
+//	    cursor, _ := cf.CreateCursor(img, 0, 0)
+//	    cf.SetCursorHandle(cursor)
+//	}
+type CursorFactory interface {
+	// CreateCursor uploads img as a cursor bitmap, with the hotspot (the
+	// pixel that tracks the pointer position) at (hotspotX, hotspotY) in
+	// image pixel coordinates.
+	CreateCursor(img image.Image, hotspotX, hotspotY int) (Cursor, error)
+
+	// CreateAnimatedCursor uploads a sequence of cursor frames, played
+	// back in order and looped while the cursor is active.
+	CreateAnimatedCursor(frames []CursorFrame) (Cursor, error)
+
+	// SetCursorHandle applies a previously created Cursor.
+	SetCursorHandle(cursor Cursor)
+}
+
+// WindowController provides window chrome and focus operations beyond the
+// geometry queries exposed by WindowProvider.
+//
+// WindowController is optional. Use type assertion to check availability:
+//
+//	if wc, ok := provider.(gpucontext.WindowController); ok {
+//	    wc.SetTitle("My App")
+//	}
+type WindowController interface {
+	// SetTitle sets the window title.
+	SetTitle(title string)
+
+	// SetFullscreen enters or exits fullscreen mode.
+	SetFullscreen(fullscreen bool)
+
+	// SetCursorGrabbed confines the cursor to the window and, on platforms
+	// that support it, switches to relative motion reporting. Used for
+	// first-person camera controls and similar pointer-lock interactions.
+	SetCursorGrabbed(grabbed bool)
+
+	// RequestAttention asks the windowing system to draw the user's
+	// attention to the window (e.g. by flashing the taskbar icon), without
+	// stealing focus.
+	RequestAttention()
+
+	// SetClipboardSelection sets the contents of the platform's
+	// selection clipboard (e.g. X11 PRIMARY), distinct from the main
+	// copy/paste clipboard. On platforms without a selection clipboard,
+	// this is a no-op.
+	SetClipboardSelection(text string)
+}
+
+// DragDropEventSource reports clipboard-update and drag-and-drop events.
+//
+// DragDropEventSource is optional, mirroring the EventSource pattern. Use
+// type assertion to check availability:
+//
+//	if dd, ok := provider.(gpucontext.DragDropEventSource); ok {
+//	    dd.OnDrop(func(paths []string) { ... })
+//	}
+type DragDropEventSource interface {
+	// OnClipboardUpdate registers a callback invoked when the system
+	// clipboard contents change.
+	OnClipboardUpdate(fn func())
+
+	// OnDragEnter registers a callback invoked when a drag operation
+	// carrying files enters the window, at position (x, y) in physical
+	// pixels.
+	OnDragEnter(fn func(x, y float64))
+
+	// OnDragOver registers a callback invoked as a drag operation moves
+	// over the window, at position (x, y) in physical pixels.
+	OnDragOver(fn func(x, y float64))
+
+	// OnDrop registers a callback invoked when the user drops files onto
+	// the window. paths are absolute filesystem paths.
+	OnDrop(fn func(paths []string))
+}
+
+// NullClipboard implements Clipboard with no-op behavior.
+// Used for testing and platforms without multi-format clipboard access.
+type NullClipboard struct{}
+
+// ReadText returns empty string and nil error.
+func (NullClipboard) ReadText(context.Context) (string, error) { return "", nil }
+
+// WriteText does nothing and returns nil.
+func (NullClipboard) WriteText(context.Context, string) error { return nil }
+
+// ReadImage returns nil and nil error.
+func (NullClipboard) ReadImage(context.Context, string) ([]byte, error) { return nil, nil }
+
+// WriteImage does nothing and returns nil.
+func (NullClipboard) WriteImage(context.Context, string, []byte) error { return nil }
+
+// Ensure NullClipboard implements Clipboard.
+var _ Clipboard = NullClipboard{}
+
+// NullCursorController implements CursorController with no-op behavior.
+// Used for testing and platforms without custom cursor support.
+type NullCursorController struct{}
+
+// SetCursor does nothing.
+func (NullCursorController) SetCursor(CursorShape) {}
+
+// SetCursorVisible does nothing.
+func (NullCursorController) SetCursorVisible(bool) {}
+
+// SetCustomCursor does nothing.
+func (NullCursorController) SetCustomCursor(image.Image, int, int) {}
+
+// Ensure NullCursorController implements CursorController.
+var _ CursorController = NullCursorController{}
+
+// NullWindowController implements WindowController with no-op behavior.
+// Used for testing and headless operation.
+type NullWindowController struct{}
+
+// SetTitle does nothing.
+func (NullWindowController) SetTitle(string) {}
+
+// SetFullscreen does nothing.
+func (NullWindowController) SetFullscreen(bool) {}
+
+// SetCursorGrabbed does nothing.
+func (NullWindowController) SetCursorGrabbed(bool) {}
+
+// RequestAttention does nothing.
+func (NullWindowController) RequestAttention() {}
+
+// SetClipboardSelection does nothing.
+func (NullWindowController) SetClipboardSelection(string) {}
+
+// Ensure NullWindowController implements WindowController.
+var _ WindowController = NullWindowController{}
+
+// NullDragDropEventSource implements DragDropEventSource with no-op behavior.
+// Used for testing and platforms without drag-and-drop support.
+type NullDragDropEventSource struct{}
+
+// OnClipboardUpdate does nothing.
+func (NullDragDropEventSource) OnClipboardUpdate(func()) {}
+
+// OnDragEnter does nothing.
+func (NullDragDropEventSource) OnDragEnter(func(x, y float64)) {}
+
+// OnDragOver does nothing.
+func (NullDragDropEventSource) OnDragOver(func(x, y float64)) {}
+
+// OnDrop does nothing.
+func (NullDragDropEventSource) OnDrop(func(paths []string)) {}
+
+// Ensure NullDragDropEventSource implements DragDropEventSource.
+var _ DragDropEventSource = NullDragDropEventSource{}
@@ -0,0 +1,96 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNullClipboard_ReadWriteText(t *testing.T) {
+	var cb Clipboard = NullClipboard{}
+	ctx := context.Background()
+
+	text, err := cb.ReadText(ctx)
+	if text != "" {
+		t.Errorf("ReadText() text = %q, want empty", text)
+	}
+	if err != nil {
+		t.Errorf("ReadText() err = %v, want nil", err)
+	}
+
+	if err := cb.WriteText(ctx, "hello"); err != nil {
+		t.Errorf("WriteText() err = %v, want nil", err)
+	}
+}
+
+func TestNullClipboard_ReadWriteImage(t *testing.T) {
+	var cb Clipboard = NullClipboard{}
+	ctx := context.Background()
+
+	data, err := cb.ReadImage(ctx, "image/png")
+	if data != nil {
+		t.Errorf("ReadImage() data = %v, want nil", data)
+	}
+	if err != nil {
+		t.Errorf("ReadImage() err = %v, want nil", err)
+	}
+
+	if err := cb.WriteImage(ctx, "image/png", []byte{1, 2, 3}); err != nil {
+		t.Errorf("WriteImage() err = %v, want nil", err)
+	}
+}
+
+func TestClipboardItem_Fields(t *testing.T) {
+	item := ClipboardItem{Format: ClipboardFormatHTML, Data: []byte("<b>hi</b>")}
+
+	if item.Format != ClipboardFormatHTML {
+		t.Errorf("Format = %q, want %q", item.Format, ClipboardFormatHTML)
+	}
+	if string(item.Data) != "<b>hi</b>" {
+		t.Errorf("Data = %q, want %q", item.Data, "<b>hi</b>")
+	}
+}
+
+func TestCursorFrame_Fields(t *testing.T) {
+	frame := CursorFrame{Duration: 50}
+
+	if frame.Image != nil {
+		t.Errorf("Image = %v, want nil", frame.Image)
+	}
+	if frame.Duration != 50 {
+		t.Errorf("Duration = %v, want 50", frame.Duration)
+	}
+}
+
+func TestNullCursorController(t *testing.T) {
+	var cc CursorController = NullCursorController{}
+
+	// All calls should be accepted without panic.
+	cc.SetCursor(CursorGrab)
+	cc.SetCursorVisible(false)
+	cc.SetCustomCursor(nil, 0, 0)
+}
+
+func TestNullWindowController(t *testing.T) {
+	var wc WindowController = NullWindowController{}
+
+	// All calls should be accepted without panic.
+	wc.SetTitle("test")
+	wc.SetFullscreen(true)
+	wc.SetCursorGrabbed(true)
+	wc.RequestAttention()
+	wc.SetClipboardSelection("selection")
+}
+
+func TestNullDragDropEventSource(t *testing.T) {
+	var dd DragDropEventSource = NullDragDropEventSource{}
+
+	// Registering callbacks should not panic, and the null implementation
+	// never invokes them.
+	dd.OnClipboardUpdate(func() { t.Error("OnClipboardUpdate callback should never fire") })
+	dd.OnDragEnter(func(x, y float64) { t.Error("OnDragEnter callback should never fire") })
+	dd.OnDragOver(func(x, y float64) { t.Error("OnDragOver callback should never fire") })
+	dd.OnDrop(func(paths []string) { t.Error("OnDrop callback should never fire") })
+}
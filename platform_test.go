@@ -33,7 +33,7 @@ func TestNullPlatformProvider_SetCursor(t *testing.T) {
 	cursors := []CursorShape{
 		CursorDefault, CursorPointer, CursorText, CursorCrosshair,
 		CursorMove, CursorResizeNS, CursorResizeEW, CursorResizeNWSE,
-		CursorResizeNESW, CursorNotAllowed, CursorWait, CursorNone,
+		CursorResizeNESW, CursorNotAllowed, CursorWait, CursorNone, CursorGrab,
 	}
 	for _, c := range cursors {
 		pp.SetCursor(c)
@@ -74,6 +74,7 @@ func TestCursorShape_String(t *testing.T) {
 		{CursorNotAllowed, "NotAllowed"},
 		{CursorWait, "Wait"},
 		{CursorNone, "None"},
+		{CursorGrab, "Grab"},
 		{CursorShape(99), "Unknown"},
 	}
 
@@ -124,6 +125,53 @@ func TestCursorShape_Values(t *testing.T) {
 	if CursorNone != 11 {
 		t.Errorf("CursorNone = %d, want 11", CursorNone)
 	}
+	if CursorGrab != 12 {
+		t.Errorf("CursorGrab = %d, want 12", CursorGrab)
+	}
+}
+
+func TestNullPlatformProvider_ClipboardProvider(t *testing.T) {
+	var cp ClipboardProvider = NullPlatformProvider{}
+
+	if got := cp.ClipboardFormats(); got != nil {
+		t.Errorf("ClipboardFormats() = %v, want nil", got)
+	}
+
+	data, err := cp.ClipboardReadFormat(ClipboardFormatPNG)
+	if data != nil || err != nil {
+		t.Errorf("ClipboardReadFormat() = %v, %v, want nil, nil", data, err)
+	}
+
+	err = cp.ClipboardWriteItems([]ClipboardItem{{Format: ClipboardFormatText, Data: []byte("hi")}})
+	if err != nil {
+		t.Errorf("ClipboardWriteItems() err = %v, want nil", err)
+	}
+}
+
+func TestNullPlatformProvider_CursorFactory(t *testing.T) {
+	var cf CursorFactory = NullPlatformProvider{}
+
+	cursor, err := cf.CreateCursor(nil, 0, 0)
+	if err != nil {
+		t.Errorf("CreateCursor() err = %v, want nil", err)
+	}
+	if cursor == nil {
+		t.Error("CreateCursor() cursor = nil, want a no-op Cursor")
+	}
+
+	animated, err := cf.CreateAnimatedCursor(nil)
+	if err != nil {
+		t.Errorf("CreateAnimatedCursor() err = %v, want nil", err)
+	}
+	if animated == nil {
+		t.Error("CreateAnimatedCursor() cursor = nil, want a no-op Cursor")
+	}
+
+	// Should not panic.
+	cf.SetCursorHandle(cursor)
+
+	// Cursor handles should themselves be releasable without panicking.
+	cursor.Release()
 }
 
 // mockPlatformProvider verifies the interface can be implemented by custom types.
@@ -3,7 +3,10 @@
 
 package gpucontext
 
-import "time"
+import (
+	"math"
+	"time"
+)
 
 // PointerEvent represents a unified pointer event following W3C Pointer Events Level 3.
 //
@@ -22,7 +25,7 @@ import "time"
 // Example usage:
 //
 //	source.OnPointer(func(ev gpucontext.PointerEvent) {
-//	    switch ev.Type {
+//	    switch ev.Kind {
 //	    case gpucontext.PointerDown:
 //	        startDrag(ev.PointerID, ev.X, ev.Y)
 //	    case gpucontext.PointerMove:
@@ -34,8 +37,12 @@ import "time"
 //	    }
 //	})
 type PointerEvent struct {
-	// Type indicates the type of pointer event (down, up, move, etc.).
-	Type PointerEventType
+	// Kind indicates the type of pointer event (down, up, move, etc.).
+	//
+	// This field was named Type prior to this release; Type shadowed Go's
+	// built-in "Type" idiom and read awkwardly at call sites. Use Type()
+	// (deprecated) if migrating incrementally.
+	Kind PointerKind
 
 	// PointerID uniquely identifies the pointer causing this event.
 	// For mouse, this is typically 1. For touch/pen, each contact has a unique ID.
@@ -77,6 +84,18 @@ type PointerEvent struct {
 	// 0 when not supported.
 	Twist float32
 
+	// Orientation is the azimuth angle of the stylus tilt, in radians.
+	// 0 means the stylus top points away from the user ("up" on screen),
+	// increasing clockwise. Derived from TiltX/TiltY via
+	// TiltToOrientationInclination when the platform only reports tilt.
+	// 0 when not supported.
+	Orientation float32
+
+	// Distance is the hover distance of the stylus above the surface, in
+	// logical pixels. 0 when the stylus is in contact with the surface,
+	// greater than 0 when hovering. 0 for devices without hover support.
+	Distance float32
+
 	// Width is the width of the contact geometry in logical pixels.
 	// For devices that don't support contact geometry, this is 1.
 	Width float32
@@ -94,11 +113,13 @@ type PointerEvent struct {
 	IsPrimary bool
 
 	// Button indicates which button triggered this event.
-	// Only meaningful for PointerDown and PointerUp events.
+	// Meaningful for PointerDown, PointerUp, PointerButtonPress, and
+	// PointerButtonRelease events - the specific button that changed.
 	// For PointerMove, this is ButtonNone.
 	Button Button
 
-	// Buttons is a bitmask of all currently pressed buttons.
+	// Buttons is a bitmask of all currently pressed buttons, reflecting
+	// the resulting state after Button's change has been applied.
 	// This allows tracking multiple button states during movement.
 	Buttons Buttons
 
@@ -109,17 +130,111 @@ type PointerEvent struct {
 	// Useful for calculating velocities and detecting double-clicks.
 	// Zero if timestamps are not available on the platform.
 	Timestamp time.Duration
+
+	// Historical contains intermediate samples batched since the previous
+	// delivered event, oldest first. High-frequency devices (120Hz+ touch
+	// panels, high-DPI mice) can generate samples faster than once per
+	// frame; when coalescing is enabled (the default, see
+	// CoalescingPointerEventSource), those samples are attached here
+	// instead of being dropped, so ink/drawing apps can render smooth
+	// strokes. Empty when the platform has nothing to coalesce.
+	Historical []PointerSample
+
+	// Predicted contains samples extrapolated forward from this event,
+	// for ink/drawing apps that want to render a short speculative
+	// extension of a stroke to hide input latency, redrawing it once the
+	// next real PointerMove arrives. Populated by the platform layer
+	// where available, or synthesized with PredictSamples; empty when
+	// prediction is unavailable or disabled.
+	Predicted []PointerSample
+}
+
+// PointerSample is a single intermediate sample batched into
+// PointerEvent.Historical.
+type PointerSample struct {
+	// X is the horizontal position in logical pixels.
+	X float64
+
+	// Y is the vertical position in logical pixels.
+	Y float64
+
+	// Pressure is the normalized pressure. See PointerEvent.Pressure.
+	Pressure float32
+
+	// TiltX is the X-axis tilt in degrees. See PointerEvent.TiltX.
+	TiltX float32
+
+	// TiltY is the Y-axis tilt in degrees. See PointerEvent.TiltY.
+	TiltY float32
+
+	// Timestamp is the sample time as duration since an arbitrary reference.
+	Timestamp time.Duration
+}
+
+// ForEachSample walks Historical (oldest first) followed by the event's own
+// current values, invoking fn once per sample. This is a convenience for
+// stroke renderers that want to treat every batched sample uniformly
+// without special-casing the "current" values.
+func (ev PointerEvent) ForEachSample(fn func(PointerSample)) {
+	for _, s := range ev.Historical {
+		fn(s)
+	}
+	fn(PointerSample{
+		X:         ev.X,
+		Y:         ev.Y,
+		Pressure:  ev.Pressure,
+		TiltX:     ev.TiltX,
+		TiltY:     ev.TiltY,
+		Timestamp: ev.Timestamp,
+	})
+}
+
+// GetCoalescedEvents returns the intermediate samples batched since the
+// previous delivered event, oldest first. It is equivalent to Historical;
+// the method exists to mirror the naming of the DOM Pointer Events Level 3
+// getCoalescedEvents() API for code being ported from it.
+func (ev PointerEvent) GetCoalescedEvents() []PointerSample { return ev.Historical }
+
+// GetPredictedEvents returns samples extrapolated forward from this
+// event. It is equivalent to Predicted; the method exists to mirror the
+// naming of the DOM Pointer Events Level 3 getPredictedEvents() API for
+// code being ported from it.
+func (ev PointerEvent) GetPredictedEvents() []PointerSample { return ev.Predicted }
+
+// Type returns the pointer event kind.
+//
+// Deprecated: use the Kind field directly. Type exists for one release
+// cycle and will be removed afterward. It is a method, not a field: code
+// that read the old Type field compiles only after ev.Type becomes
+// ev.Type() or ev.Kind, and composite literals that set Type must set
+// Kind instead.
+func (ev PointerEvent) Type() PointerKind { return ev.Kind }
+
+// IsButtonEvent returns true if Kind is a discrete button state change
+// (PointerButtonPress or PointerButtonRelease), as opposed to a pointer
+// contact or motion event. Useful for filtering barrel/side button
+// transitions out of a handler that only cares about contact and drag.
+func (ev PointerEvent) IsButtonEvent() bool {
+	return ev.Kind == PointerButtonPress || ev.Kind == PointerButtonRelease
 }
 
-// PointerEventType indicates the type of pointer event.
-type PointerEventType uint8
+// PointerKind indicates the type of pointer event.
+//
+// PointerKind was named PointerEventType prior to this release.
+// PointerEventType is kept as an alias for one release cycle.
+type PointerKind uint8
+
+// PointerEventType is a deprecated alias for PointerKind.
+//
+// Deprecated: use PointerKind.
+type PointerEventType = PointerKind
 
 const (
 	// PointerDown is fired when a pointer becomes active.
 	// For mouse: button press.
 	// For touch: contact starts.
 	// For pen: contact with or hover above the digitizer.
-	PointerDown PointerEventType = iota
+	PointerDown PointerKind = iota
 
 	// PointerUp is fired when a pointer is no longer active.
 	// For mouse: button release.
@@ -146,10 +261,35 @@ const (
 	//   - The application loses focus during an active pointer
 	// Always handle cancellation to reset state properly.
 	PointerCancel
+
+	// PointerButtonPress is fired when a button changes to pressed
+	// independently of PointerDown, e.g. a stylus barrel button or a mouse
+	// side button toggled mid-drag. When coincident with PointerDown
+	// (the primary button causing contact), PointerButtonPress is
+	// delivered after PointerDown.
+	PointerButtonPress
+
+	// PointerButtonRelease is fired when a button changes to released
+	// independently of PointerUp. When coincident with PointerUp,
+	// PointerButtonRelease is delivered before PointerUp.
+	PointerButtonRelease
+
+	// HoverBegan is fired when a hovering stylus enters detection range
+	// above the surface without making contact. Distance is greater than 0.
+	HoverBegan
+
+	// HoverMoved is fired when a hovering stylus moves, or its Distance,
+	// Orientation, or other hover properties change.
+	HoverMoved
+
+	// HoverEnded is fired when a hovering stylus leaves detection range,
+	// either by contacting the surface (followed by PointerDown) or by
+	// moving out of range entirely.
+	HoverEnded
 )
 
-// String returns the event type name for debugging.
-func (t PointerEventType) String() string {
+// String returns the event kind name for debugging.
+func (t PointerKind) String() string {
 	switch t {
 	case PointerDown:
 		return "PointerDown"
@@ -163,6 +303,16 @@ func (t PointerEventType) String() string {
 		return "PointerLeave"
 	case PointerCancel:
 		return "PointerCancel"
+	case PointerButtonPress:
+		return "PointerButtonPress"
+	case PointerButtonRelease:
+		return "PointerButtonRelease"
+	case HoverBegan:
+		return "HoverBegan"
+	case HoverMoved:
+		return "HoverMoved"
+	case HoverEnded:
+		return "HoverEnded"
 	default:
 		return "Unknown"
 	}
@@ -225,6 +375,15 @@ const (
 
 	// ButtonEraser is the eraser button on a pen (if available).
 	ButtonEraser Button = 5
+
+	// ButtonStylusPrimary is the primary barrel button on a stylus,
+	// distinct from ButtonX1 so drawing apps can tell a pen barrel press
+	// apart from a mouse thumb button.
+	ButtonStylusPrimary Button = 6
+
+	// ButtonStylusSecondary is the secondary barrel button on a stylus,
+	// distinct from ButtonX2.
+	ButtonStylusSecondary Button = 7
 )
 
 // String returns the button name for debugging.
@@ -244,6 +403,10 @@ func (b Button) String() string {
 		return "X2"
 	case ButtonEraser:
 		return "Eraser"
+	case ButtonStylusPrimary:
+		return "StylusPrimary"
+	case ButtonStylusSecondary:
+		return "StylusSecondary"
 	default:
 		return "Unknown"
 	}
@@ -274,6 +437,12 @@ const (
 
 	// ButtonsEraser indicates the eraser button is pressed.
 	ButtonsEraser Buttons = 1 << 5
+
+	// ButtonsStylusPrimary indicates the stylus's primary barrel button is pressed.
+	ButtonsStylusPrimary Buttons = 1 << 6
+
+	// ButtonsStylusSecondary indicates the stylus's secondary barrel button is pressed.
+	ButtonsStylusSecondary Buttons = 1 << 7
 )
 
 // HasLeft returns true if the left button is pressed.
@@ -306,6 +475,16 @@ func (b Buttons) HasEraser() bool {
 	return b&ButtonsEraser != 0
 }
 
+// HasStylusPrimary returns true if the stylus's primary barrel button is pressed.
+func (b Buttons) HasStylusPrimary() bool {
+	return b&ButtonsStylusPrimary != 0
+}
+
+// HasStylusSecondary returns true if the stylus's secondary barrel button is pressed.
+func (b Buttons) HasStylusSecondary() bool {
+	return b&ButtonsStylusSecondary != 0
+}
+
 // Count returns the number of pressed buttons.
 func (b Buttons) Count() int {
 	count := 0
@@ -315,6 +494,24 @@ func (b Buttons) Count() int {
 	return count
 }
 
+// TiltToOrientationInclination derives a stylus's azimuth orientation and
+// inclination angle from its raw tilt along the X and Y axes, following the
+// standard transform used by platforms that report tilt but not
+// orientation/inclination directly (e.g. the W3C Pointer Events tiltX/tiltY
+// model).
+//
+// tiltX and tiltY are in radians. The result orientation is normalized to
+// [0, 2π) and inclination is in [0, π/2], where 0 means perpendicular to
+// the surface.
+func TiltToOrientationInclination(tiltX, tiltY float64) (orientation, inclination float64) {
+	inclination = math.Acos(math.Cos(tiltX) * math.Cos(tiltY))
+	orientation = math.Atan2(-math.Sin(tiltY), math.Sin(tiltX)*math.Cos(tiltY))
+	if orientation < 0 {
+		orientation += 2 * math.Pi
+	}
+	return orientation, inclination
+}
+
 // PointerEventSource extends EventSource with unified pointer event capabilities.
 //
 // This interface provides W3C Pointer Events Level 3 compliant pointer input,
@@ -342,9 +539,36 @@ type PointerEventSource interface {
 	//
 	// Pointer events are delivered in order:
 	//   PointerEnter -> PointerDown -> PointerMove* -> PointerUp/PointerCancel -> PointerLeave
+	//
+	// PointerButtonPress/PointerButtonRelease are delivered independently
+	// of contact changes (e.g. a stylus barrel button toggled mid-drag).
+	// When coincident with contact, PointerButtonPress is delivered after
+	// PointerDown and PointerButtonRelease is delivered before PointerUp.
 	OnPointer(fn func(PointerEvent))
 }
 
+// CoalescingPointerEventSource is an optional capability of
+// PointerEventSource that lets consumers choose between coalesced and raw
+// event delivery.
+//
+// CoalescingPointerEventSource is optional. Use type assertion to check
+// availability:
+//
+//	if cps, ok := pes.(gpucontext.CoalescingPointerEventSource); ok {
+//	    cps.SetCoalescing(false) // want one event per raw sample
+//	}
+type CoalescingPointerEventSource interface {
+	// SetCoalescing selects the event delivery mode.
+	//
+	// When enabled (the default), the source delivers at most one
+	// PointerEvent per frame, with any intermediate samples attached via
+	// PointerEvent.Historical. When disabled, the source delivers one
+	// PointerEvent per raw sample with Historical always empty - useful
+	// for consumers that want to process every sample as it arrives
+	// rather than waiting for the next frame.
+	SetCoalescing(enabled bool)
+}
+
 // NullPointerEventSource implements PointerEventSource by ignoring all registrations.
 // Useful for platforms or configurations where pointer input is not available.
 type NullPointerEventSource struct{}
@@ -352,5 +576,11 @@ type NullPointerEventSource struct{}
 // OnPointer does nothing.
 func (NullPointerEventSource) OnPointer(func(PointerEvent)) {}
 
+// SetCoalescing does nothing.
+func (NullPointerEventSource) SetCoalescing(bool) {}
+
 // Ensure NullPointerEventSource implements PointerEventSource.
 var _ PointerEventSource = NullPointerEventSource{}
+
+// Ensure NullPointerEventSource implements CoalescingPointerEventSource.
+var _ CoalescingPointerEventSource = NullPointerEventSource{}
@@ -0,0 +1,115 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrPointerNotActive is returned by PointerCaptureSource.SetPointerCapture
+// when pointerID does not currently identify an active pointer (no
+// PointerDown has been delivered for it, or it has already gone up or
+// been cancelled).
+var ErrPointerNotActive = errors.New("gpucontext: pointer is not active")
+
+// PointerCaptureEventType classifies a PointerCaptureEvent.
+type PointerCaptureEventType uint8
+
+const (
+	// PointerCaptureGained corresponds to the W3C gotpointercapture
+	// event, fired when SetPointerCapture succeeds.
+	PointerCaptureGained PointerCaptureEventType = iota
+
+	// PointerCaptureLost corresponds to the W3C lostpointercapture
+	// event, fired when capture ends: ReleasePointerCapture is called,
+	// the pointer goes up or is cancelled, or another target captures
+	// the same pointer.
+	PointerCaptureLost
+)
+
+// String returns the capture event type name for debugging.
+func (t PointerCaptureEventType) String() string {
+	switch t {
+	case PointerCaptureGained:
+		return "Gained"
+	case PointerCaptureLost:
+		return "Lost"
+	default:
+		return "Unknown"
+	}
+}
+
+// PointerCaptureEvent notifies that a pointer's capture state changed.
+type PointerCaptureEvent struct {
+	// PointerID identifies the pointer whose capture state changed.
+	PointerID int
+
+	// Type classifies this event.
+	Type PointerCaptureEventType
+
+	// Timestamp is the event time as duration since an arbitrary
+	// reference. Zero if timestamps are not available.
+	Timestamp time.Duration
+}
+
+// PointerCaptureSource extends PointerEventSource with the W3C Pointer
+// Events Level 3 capture model: once a pointer is captured, all
+// subsequent events for it - including PointerMove outside the window
+// bounds and the eventual PointerUp, even after the pointer has left the
+// window - are delivered as if it never left, until capture is released
+// or the pointer is cancelled. This is essential for drag operations
+// (window resize handles, scrollbar thumbs, canvas panning) that must
+// keep tracking the pointer past the window edge, which the plain
+// PointerEnter/PointerLeave model cannot express.
+//
+// PointerCaptureSource is optional. Use type assertion to check
+// availability:
+//
+//	if pcs, ok := pes.(gpucontext.PointerCaptureSource); ok {
+//	    pcs.SetPointerCapture(ev.PointerID)
+//	}
+type PointerCaptureSource interface {
+	// SetPointerCapture redirects all subsequent events for pointerID to
+	// this source's normal delivery path regardless of window bounds,
+	// and fires PointerCaptureGained. Returns ErrPointerNotActive if
+	// pointerID is not currently active.
+	SetPointerCapture(pointerID int) error
+
+	// ReleasePointerCapture ends capture for pointerID, firing
+	// PointerCaptureLost. Does nothing if pointerID is not captured.
+	ReleasePointerCapture(pointerID int)
+
+	// HasPointerCapture reports whether pointerID is currently captured.
+	HasPointerCapture(pointerID int) bool
+
+	// OnPointerCaptureChange registers a callback for
+	// PointerCaptureGained/PointerCaptureLost notifications.
+	//
+	// Callback threading: Called on the main/UI thread.
+	// Callbacks should be fast and non-blocking.
+	OnPointerCaptureChange(fn func(PointerCaptureEvent))
+}
+
+// NullPointerCaptureSource implements PointerCaptureSource by refusing to
+// capture anything. Used for testing and platforms without pointer
+// capture support.
+type NullPointerCaptureSource struct{}
+
+// SetPointerCapture always returns ErrPointerNotActive.
+func (NullPointerCaptureSource) SetPointerCapture(pointerID int) error {
+	return ErrPointerNotActive
+}
+
+// ReleasePointerCapture does nothing.
+func (NullPointerCaptureSource) ReleasePointerCapture(pointerID int) {}
+
+// HasPointerCapture always returns false.
+func (NullPointerCaptureSource) HasPointerCapture(pointerID int) bool { return false }
+
+// OnPointerCaptureChange does nothing.
+func (NullPointerCaptureSource) OnPointerCaptureChange(fn func(PointerCaptureEvent)) {}
+
+// Ensure NullPointerCaptureSource implements PointerCaptureSource.
+var _ PointerCaptureSource = NullPointerCaptureSource{}
@@ -0,0 +1,44 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "testing"
+
+func TestPointerCaptureEventType_String(t *testing.T) {
+	tests := []struct {
+		typ  PointerCaptureEventType
+		want string
+	}{
+		{PointerCaptureGained, "Gained"},
+		{PointerCaptureLost, "Lost"},
+		{PointerCaptureEventType(99), "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.typ.String(); got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullPointerCaptureSource(t *testing.T) {
+	var pcs PointerCaptureSource = NullPointerCaptureSource{}
+
+	if err := pcs.SetPointerCapture(1); err != ErrPointerNotActive {
+		t.Errorf("SetPointerCapture() = %v, want ErrPointerNotActive", err)
+	}
+	if pcs.HasPointerCapture(1) {
+		t.Error("HasPointerCapture() = true, want false")
+	}
+
+	called := false
+	pcs.OnPointerCaptureChange(func(PointerCaptureEvent) { called = true })
+	pcs.ReleasePointerCapture(1)
+	if called {
+		t.Error("NullPointerCaptureSource should not call the callback")
+	}
+
+	var _ PointerCaptureSource = NullPointerCaptureSource{}
+}
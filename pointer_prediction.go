@@ -0,0 +1,42 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "time"
+
+// PredictSamples extrapolates one sample forward from history (oldest
+// first, not including current) plus current, using a constant-velocity
+// fit over at most the last 3 samples, projected ahead by frameTime. It
+// returns nil if fewer than 2 samples are available or their timestamps
+// don't advance, since no velocity can be fit.
+//
+// Backends that don't natively report predicted events (X11, older
+// Wayland) can call this from Go to populate PointerEvent.Predicted
+// instead of leaving it empty.
+func PredictSamples(history []PointerSample, current PointerSample, frameTime time.Duration) []PointerSample {
+	fit := history
+	if len(fit) > 2 {
+		fit = fit[len(fit)-2:]
+	}
+	fit = append(append([]PointerSample{}, fit...), current)
+
+	first, last := fit[0], fit[len(fit)-1]
+	dt := (last.Timestamp - first.Timestamp).Seconds()
+	if dt <= 0 {
+		return nil
+	}
+
+	vx := (last.X - first.X) / dt
+	vy := (last.Y - first.Y) / dt
+	step := frameTime.Seconds()
+
+	return []PointerSample{{
+		X:         last.X + vx*step,
+		Y:         last.Y + vy*step,
+		Pressure:  last.Pressure,
+		TiltX:     last.TiltX,
+		TiltY:     last.TiltY,
+		Timestamp: last.Timestamp + frameTime,
+	}}
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPredictSamples_ConstantVelocity(t *testing.T) {
+	history := []PointerSample{
+		{X: 0, Y: 0, Timestamp: 0},
+		{X: 10, Y: 0, Timestamp: 10 * time.Millisecond},
+	}
+	current := PointerSample{X: 20, Y: 0, Timestamp: 20 * time.Millisecond}
+
+	got := PredictSamples(history, current, 10*time.Millisecond)
+	if len(got) != 1 {
+		t.Fatalf("got %d predicted samples, want 1", len(got))
+	}
+	if got[0].X != 30 || got[0].Y != 0 {
+		t.Errorf("predicted = %+v, want X=30 Y=0", got[0])
+	}
+	if got[0].Timestamp != 30*time.Millisecond {
+		t.Errorf("predicted.Timestamp = %v, want 30ms", got[0].Timestamp)
+	}
+}
+
+func TestPredictSamples_UsesOnlyLastThreeSamples(t *testing.T) {
+	history := []PointerSample{
+		{X: 1000, Y: 0, Timestamp: 0}, // far outlier, should be dropped from the fit
+		{X: 0, Y: 0, Timestamp: 10 * time.Millisecond},
+		{X: 10, Y: 0, Timestamp: 20 * time.Millisecond},
+	}
+	current := PointerSample{X: 20, Y: 0, Timestamp: 30 * time.Millisecond}
+
+	got := PredictSamples(history, current, 10*time.Millisecond)
+	if len(got) != 1 || got[0].X != 30 {
+		t.Errorf("predicted = %+v, want X=30 (fit over last 3 samples only)", got)
+	}
+}
+
+func TestPredictSamples_InsufficientHistoryReturnsNil(t *testing.T) {
+	current := PointerSample{X: 0, Y: 0, Timestamp: 0}
+	if got := PredictSamples(nil, current, 10*time.Millisecond); got != nil {
+		t.Errorf("got %+v, want nil with no history", got)
+	}
+}
+
+func TestPredictSamples_ZeroDtReturnsNil(t *testing.T) {
+	history := []PointerSample{{X: 0, Y: 0, Timestamp: 5 * time.Millisecond}}
+	current := PointerSample{X: 10, Y: 0, Timestamp: 5 * time.Millisecond}
+	if got := PredictSamples(history, current, 10*time.Millisecond); got != nil {
+		t.Errorf("got %+v, want nil with zero elapsed time", got)
+	}
+}
@@ -4,14 +4,15 @@
 package gpucontext
 
 import (
+	"math"
 	"testing"
 	"time"
 )
 
-func TestPointerEventType_String(t *testing.T) {
+func TestPointerKind_String(t *testing.T) {
 	tests := []struct {
-		eventType PointerEventType
-		want      string
+		kind PointerKind
+		want string
 	}{
 		{PointerDown, "PointerDown"},
 		{PointerUp, "PointerUp"},
@@ -19,18 +20,40 @@ func TestPointerEventType_String(t *testing.T) {
 		{PointerEnter, "PointerEnter"},
 		{PointerLeave, "PointerLeave"},
 		{PointerCancel, "PointerCancel"},
-		{PointerEventType(99), "Unknown"},
+		{PointerButtonPress, "PointerButtonPress"},
+		{PointerButtonRelease, "PointerButtonRelease"},
+		{HoverBegan, "HoverBegan"},
+		{HoverMoved, "HoverMoved"},
+		{HoverEnded, "HoverEnded"},
+		{PointerKind(99), "Unknown"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
-			if got := tt.eventType.String(); got != tt.want {
-				t.Errorf("PointerEventType(%d).String() = %q, want %q", tt.eventType, got, tt.want)
+			if got := tt.kind.String(); got != tt.want {
+				t.Errorf("PointerKind(%d).String() = %q, want %q", tt.kind, got, tt.want)
 			}
 		})
 	}
 }
 
+func TestPointerEventType_IsPointerKindAlias(t *testing.T) {
+	// PointerEventType is a deprecated alias for PointerKind; a value of
+	// one must be directly assignable to the other.
+	var k PointerKind = PointerDown
+	var legacy PointerEventType = k
+	if legacy != PointerDown {
+		t.Errorf("legacy = %v, want PointerDown", legacy)
+	}
+}
+
+func TestPointerEvent_Type_Deprecated(t *testing.T) {
+	ev := PointerEvent{Kind: PointerUp}
+	if got := ev.Type(); got != PointerUp {
+		t.Errorf("ev.Type() = %v, want PointerUp", got)
+	}
+}
+
 func TestPointerType_String(t *testing.T) {
 	tests := []struct {
 		pointerType PointerType
@@ -63,6 +86,8 @@ func TestButton_String(t *testing.T) {
 		{ButtonX1, "X1"},
 		{ButtonX2, "X2"},
 		{ButtonEraser, "Eraser"},
+		{ButtonStylusPrimary, "StylusPrimary"},
+		{ButtonStylusSecondary, "StylusSecondary"},
 		{Button(99), "Unknown"},
 	}
 
@@ -95,6 +120,37 @@ func TestButtonConstants(t *testing.T) {
 	if ButtonX2 != 4 {
 		t.Errorf("ButtonX2 = %d, want 4", ButtonX2)
 	}
+	if ButtonStylusPrimary != 6 {
+		t.Errorf("ButtonStylusPrimary = %d, want 6", ButtonStylusPrimary)
+	}
+	if ButtonStylusSecondary != 7 {
+		t.Errorf("ButtonStylusSecondary = %d, want 7", ButtonStylusSecondary)
+	}
+}
+
+func TestButtons_HasStylusMethods(t *testing.T) {
+	tests := []struct {
+		name      string
+		buttons   Buttons
+		primary   bool
+		secondary bool
+	}{
+		{"none", ButtonsNone, false, false},
+		{"primary only", ButtonsStylusPrimary, true, false},
+		{"secondary only", ButtonsStylusSecondary, false, true},
+		{"both", ButtonsStylusPrimary | ButtonsStylusSecondary, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.buttons.HasStylusPrimary(); got != tt.primary {
+				t.Errorf("HasStylusPrimary() = %v, want %v", got, tt.primary)
+			}
+			if got := tt.buttons.HasStylusSecondary(); got != tt.secondary {
+				t.Errorf("HasStylusSecondary() = %v, want %v", got, tt.secondary)
+			}
+		})
+	}
 }
 
 func TestButtons_HasMethods(t *testing.T) {
@@ -191,8 +247,8 @@ func TestButtonsConstants(t *testing.T) {
 func TestPointerEvent_ZeroValue(t *testing.T) {
 	var ev PointerEvent
 
-	if ev.Type != PointerDown {
-		t.Errorf("Zero value Type = %v, want PointerDown", ev.Type)
+	if ev.Kind != PointerDown {
+		t.Errorf("Zero value Kind = %v, want PointerDown", ev.Kind)
 	}
 	if ev.PointerID != 0 {
 		t.Errorf("Zero value PointerID = %d, want 0", ev.PointerID)
@@ -216,7 +272,7 @@ func TestPointerEvent_ZeroValue(t *testing.T) {
 
 func TestPointerEvent_FullConstruction(t *testing.T) {
 	ev := PointerEvent{
-		Type:        PointerMove,
+		Kind:        PointerMove,
 		PointerID:   42,
 		X:           100.5,
 		Y:           200.5,
@@ -234,8 +290,8 @@ func TestPointerEvent_FullConstruction(t *testing.T) {
 		Timestamp:   time.Millisecond * 12345,
 	}
 
-	if ev.Type != PointerMove {
-		t.Errorf("Type = %v, want PointerMove", ev.Type)
+	if ev.Kind != PointerMove {
+		t.Errorf("Kind = %v, want PointerMove", ev.Kind)
 	}
 	if ev.PointerID != 42 {
 		t.Errorf("PointerID = %d, want 42", ev.PointerID)
@@ -290,7 +346,7 @@ func TestPointerEvent_FullConstruction(t *testing.T) {
 	}
 }
 
-func TestPointerEventType_Values(t *testing.T) {
+func TestPointerKind_Values(t *testing.T) {
 	// Verify event type constants are sequential
 	if PointerDown != 0 {
 		t.Errorf("PointerDown = %d, want 0", PointerDown)
@@ -310,6 +366,87 @@ func TestPointerEventType_Values(t *testing.T) {
 	if PointerCancel != 5 {
 		t.Errorf("PointerCancel = %d, want 5", PointerCancel)
 	}
+	if PointerButtonPress != 6 {
+		t.Errorf("PointerButtonPress = %d, want 6", PointerButtonPress)
+	}
+	if PointerButtonRelease != 7 {
+		t.Errorf("PointerButtonRelease = %d, want 7", PointerButtonRelease)
+	}
+	if HoverBegan != 8 {
+		t.Errorf("HoverBegan = %d, want 8", HoverBegan)
+	}
+	if HoverMoved != 9 {
+		t.Errorf("HoverMoved = %d, want 9", HoverMoved)
+	}
+	if HoverEnded != 10 {
+		t.Errorf("HoverEnded = %d, want 10", HoverEnded)
+	}
+}
+
+func TestTiltToOrientationInclination(t *testing.T) {
+	tests := []struct {
+		name            string
+		tiltX, tiltY    float64
+		wantOrientation float64
+		wantInclination float64
+	}{
+		{"no tilt", 0, 0, 0, 0},
+		{"tilt right only", math.Pi / 4, 0, 0, math.Pi / 4},
+		{"tilt toward user only", 0, math.Pi / 4, 3 * math.Pi / 2, math.Pi / 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orientation, inclination := TiltToOrientationInclination(tt.tiltX, tt.tiltY)
+			if !almostEqual(orientation, tt.wantOrientation) {
+				t.Errorf("orientation = %f, want %f", orientation, tt.wantOrientation)
+			}
+			if !almostEqual(inclination, tt.wantInclination) {
+				t.Errorf("inclination = %f, want %f", inclination, tt.wantInclination)
+			}
+		})
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	const epsilon = 1e-9
+	d := a - b
+	if d < 0 {
+		d = -d
+	}
+	return d < epsilon
+}
+
+func TestPointerEvent_OrientationDistance(t *testing.T) {
+	ev := PointerEvent{Kind: HoverBegan, Orientation: 1.5, Distance: 10}
+	if ev.Orientation != 1.5 {
+		t.Errorf("Orientation = %f, want 1.5", ev.Orientation)
+	}
+	if ev.Distance != 10 {
+		t.Errorf("Distance = %f, want 10", ev.Distance)
+	}
+}
+
+func TestPointerEvent_IsButtonEvent(t *testing.T) {
+	tests := []struct {
+		kind PointerKind
+		want bool
+	}{
+		{PointerDown, false},
+		{PointerUp, false},
+		{PointerMove, false},
+		{PointerButtonPress, true},
+		{PointerButtonRelease, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind.String(), func(t *testing.T) {
+			ev := PointerEvent{Kind: tt.kind}
+			if got := ev.IsButtonEvent(); got != tt.want {
+				t.Errorf("IsButtonEvent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
 }
 
 func TestPointerType_Values(t *testing.T) {
@@ -333,6 +470,65 @@ func TestNullPointerEventSource(t *testing.T) {
 	pes.OnPointer(func(PointerEvent) {})
 }
 
+func TestNullPointerEventSource_SetCoalescing(t *testing.T) {
+	var cps CoalescingPointerEventSource = NullPointerEventSource{}
+
+	// Should be callable without panic.
+	cps.SetCoalescing(false)
+	cps.SetCoalescing(true)
+}
+
+func TestPointerEvent_ForEachSample(t *testing.T) {
+	ev := PointerEvent{
+		X: 30, Y: 40, Pressure: 0.5, Timestamp: 3 * time.Millisecond,
+		Historical: []PointerSample{
+			{X: 10, Y: 10, Pressure: 0.1, Timestamp: time.Millisecond},
+			{X: 20, Y: 20, Pressure: 0.3, Timestamp: 2 * time.Millisecond},
+		},
+	}
+
+	var samples []PointerSample
+	ev.ForEachSample(func(s PointerSample) { samples = append(samples, s) })
+
+	if len(samples) != 3 {
+		t.Fatalf("got %d samples, want 3", len(samples))
+	}
+	if samples[0].X != 10 || samples[1].X != 20 || samples[2].X != 30 {
+		t.Errorf("samples out of order: %+v", samples)
+	}
+	if samples[2].Timestamp != ev.Timestamp {
+		t.Errorf("final sample timestamp = %v, want %v", samples[2].Timestamp, ev.Timestamp)
+	}
+}
+
+func TestPointerEvent_GetCoalescedEvents(t *testing.T) {
+	historical := []PointerSample{{X: 10, Y: 10, Timestamp: time.Millisecond}}
+	ev := PointerEvent{Historical: historical}
+
+	if got := ev.GetCoalescedEvents(); len(got) != 1 || got[0] != historical[0] {
+		t.Errorf("GetCoalescedEvents() = %+v, want %+v", got, historical)
+	}
+
+	var empty PointerEvent
+	if got := empty.GetCoalescedEvents(); got != nil {
+		t.Errorf("GetCoalescedEvents() on zero value = %+v, want nil", got)
+	}
+}
+
+func TestPointerEvent_GetPredictedEvents(t *testing.T) {
+	predicted := []PointerSample{{X: 30, Y: 30, Timestamp: 4 * time.Millisecond}}
+	ev := PointerEvent{Predicted: predicted}
+
+	if got := ev.GetPredictedEvents(); len(got) != 1 || got[0] != predicted[0] {
+		t.Errorf("GetPredictedEvents() = %+v, want %+v", got, predicted)
+	}
+
+	var empty PointerEvent
+	if got := empty.GetPredictedEvents(); got != nil {
+		t.Errorf("GetPredictedEvents() on zero value = %+v, want nil", got)
+	}
+}
+
 // mockPointerEventSource is used to verify PointerEventSource interface.
 type mockPointerEventSource struct {
 	handler func(PointerEvent)
@@ -357,7 +553,7 @@ func TestPointerEventSource_Interface(t *testing.T) {
 
 	// Simulate event dispatch
 	testEvent := PointerEvent{
-		Type:        PointerDown,
+		Kind:        PointerDown,
 		PointerID:   1,
 		X:           100,
 		Y:           200,
@@ -372,8 +568,8 @@ func TestPointerEventSource_Interface(t *testing.T) {
 	if received == nil {
 		t.Fatal("Handler was not called")
 	}
-	if received.Type != PointerDown {
-		t.Errorf("received.Type = %v, want PointerDown", received.Type)
+	if received.Kind != PointerDown {
+		t.Errorf("received.Kind = %v, want PointerDown", received.Kind)
 	}
 	if received.PointerID != 1 {
 		t.Errorf("received.PointerID = %d, want 1", received.PointerID)
@@ -0,0 +1,285 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "time"
+
+// DeviceKind classifies a RawDevice.
+type DeviceKind uint8
+
+const (
+	// DeviceMouse is a mouse or trackball.
+	DeviceMouse DeviceKind = iota
+
+	// DeviceTouchpad is a touchpad/trackpad reporting raw relative
+	// motion and multi-finger gestures.
+	DeviceTouchpad
+
+	// DevicePen is a stylus-capable tablet or pen display.
+	DevicePen
+
+	// DeviceTabletPad is the button/ring/strip pad bundled with a
+	// graphics tablet, separate from its pen sensor.
+	DeviceTabletPad
+)
+
+// String returns the device kind name for debugging.
+func (k DeviceKind) String() string {
+	switch k {
+	case DeviceMouse:
+		return "Mouse"
+	case DeviceTouchpad:
+		return "Touchpad"
+	case DevicePen:
+		return "Pen"
+	case DeviceTabletPad:
+		return "TabletPad"
+	default:
+		return "Unknown"
+	}
+}
+
+// AccelProfile selects a pointer acceleration curve.
+type AccelProfile uint8
+
+const (
+	// AccelProfileAdaptive scales motion by speed, the typical OS
+	// default for a mouse.
+	AccelProfileAdaptive AccelProfile = iota
+
+	// AccelProfileFlat applies a constant scale factor regardless of
+	// speed, preferred for drawing and precision pointing.
+	AccelProfileFlat
+)
+
+// String returns the acceleration profile name for debugging.
+func (p AccelProfile) String() string {
+	switch p {
+	case AccelProfileAdaptive:
+		return "Adaptive"
+	case AccelProfileFlat:
+		return "Flat"
+	default:
+		return "Unknown"
+	}
+}
+
+// DeviceCaps describes which optional, device-level capabilities a
+// RawDevice supports. A backend leaves a field false when the underlying
+// hardware or driver cannot support it; calling the corresponding
+// RawInputSource setter for an unsupported capability is a no-op.
+type DeviceCaps struct {
+	// AccelProfile indicates SetAccelProfile has an effect on this device.
+	AccelProfile bool
+
+	// NaturalScroll indicates SetNaturalScroll has an effect on this device.
+	NaturalScroll bool
+
+	// LeftHanded indicates SetLeftHanded has an effect on this device.
+	LeftHanded bool
+
+	// MiddleButtonEmulation indicates SetMiddleButtonEmulation has an
+	// effect on this device.
+	MiddleButtonEmulation bool
+
+	// WheelEmulation indicates SetWheelEmulation has an effect on this
+	// device.
+	WheelEmulation bool
+
+	// Pen indicates this device reports RawPointerEvent.Tool and
+	// BarrelButton.
+	Pen bool
+
+	// TabletPad indicates this device reports RawPointerEvent.PadButton
+	// and PadRing.
+	TabletPad bool
+}
+
+// RawDevice describes one raw input device's identity and capabilities,
+// as reported by RawInputSource.Devices. Per-device configuration is
+// applied through RawInputSource's setters, keyed by ID, following the
+// same pattern as DisplayProvider and GamepadProvider.
+type RawDevice struct {
+	// ID identifies this device for the lifetime of its connection,
+	// and is used as the key for RawInputSource's Set* methods and for
+	// RawPointerEvent.DeviceID.
+	ID string
+
+	// Name is the device's human-readable name, as reported by the
+	// platform (e.g. "Logitech MX Master 3", "Wacom Intuos Pro M").
+	Name string
+
+	// Kind classifies the device.
+	Kind DeviceKind
+
+	// Caps describes which of RawInputSource's optional, device-level
+	// capabilities this device supports.
+	Caps DeviceCaps
+}
+
+// RawPointerTool distinguishes the stylus tool end in effect for a
+// RawPointerEvent from a DevicePen device.
+type RawPointerTool uint8
+
+const (
+	// RawPointerToolNone indicates the event did not come from a pen, or
+	// no tool is in contact/hover range.
+	RawPointerToolNone RawPointerTool = iota
+
+	// RawPointerToolStylus indicates the stylus tip is in use.
+	RawPointerToolStylus
+
+	// RawPointerToolEraser indicates the stylus's eraser end is in use.
+	RawPointerToolEraser
+)
+
+// String returns the pointer tool name for debugging.
+func (t RawPointerTool) String() string {
+	switch t {
+	case RawPointerToolNone:
+		return "None"
+	case RawPointerToolStylus:
+		return "Stylus"
+	case RawPointerToolEraser:
+		return "Eraser"
+	default:
+		return "Unknown"
+	}
+}
+
+// RawPointerEvent carries sub-pixel relative motion and high-resolution
+// wheel ticks straight from a device, before they are quantized into the
+// window-relative, logical-pixel PointerEvent/ScrollEvent delivered by
+// PointerEventSource/ScrollEventSource. Drawing and DCC apps that need
+// true tablet fidelity should use RawInputSource directly; typical apps
+// should keep using the unified PointerEvent/ScrollEvent stream.
+type RawPointerEvent struct {
+	// DeviceID identifies which RawDevice produced this event.
+	DeviceID string
+
+	// DX and DY are the relative motion since the previous event, in
+	// sub-pixel device units (not scaled to logical pixels or quantized
+	// to integers).
+	DX float64
+	DY float64
+
+	// WheelTicks is the high-resolution scroll wheel delta, in
+	// fractional notches (1.0 is a standard detent click; devices with
+	// high-res wheels report fractional values between detents).
+	WheelTicks float64
+
+	// Tool identifies the stylus tool end in effect. RawPointerToolNone
+	// unless DeviceCaps.Pen is set for DeviceID.
+	Tool RawPointerTool
+
+	// BarrelButton indicates the stylus's barrel button is pressed.
+	// Meaningless unless DeviceCaps.Pen is set for DeviceID.
+	BarrelButton bool
+
+	// PadButton is the index of a tablet pad button that changed state,
+	// or -1 if none. Meaningless unless DeviceCaps.TabletPad is set for
+	// DeviceID.
+	PadButton int
+
+	// PadRing is the absolute position of a tablet pad's touch ring,
+	// normalized to [0, 1), or -1 if the pad has no ring or it is not
+	// being touched. Meaningless unless DeviceCaps.TabletPad is set for
+	// DeviceID.
+	PadRing float64
+
+	// Timestamp is the event time as duration since an arbitrary
+	// reference. Zero if timestamps are not available.
+	Timestamp time.Duration
+}
+
+// RawInputSource exposes raw, per-device input that PointerEvent cannot:
+// sub-pixel relative motion, high-resolution wheel ticks, and per-device
+// configuration (acceleration profile, left-handed swap, natural-scroll
+// toggle, middle-button and wheel emulation), plus pen-specific fields
+// (stylus vs eraser tool, barrel button) and tablet pad buttons/rings.
+//
+// RawInputSource is optional. Use type assertion to check availability:
+//
+//	if ris, ok := eventSource.(gpucontext.RawInputSource); ok {
+//	    ris.OnRawPointer(handleRawPointer)
+//	}
+//
+// Platform backends (Linux libinput/evdev, Windows Raw Input/Pointer
+// Input Messages) register their RawInputSource implementation through
+// RawInputSources rather than gpucontext importing them directly.
+type RawInputSource interface {
+	// Devices returns all currently connected raw input devices.
+	Devices() []RawDevice
+
+	// OnRawPointer registers a callback for raw pointer events.
+	//
+	// Callback threading: Called on the main/UI thread.
+	// Callbacks should be fast and non-blocking.
+	OnRawPointer(fn func(RawPointerEvent))
+
+	// SetAccelProfile sets deviceID's pointer acceleration curve.
+	// No-op if deviceID's DeviceCaps.AccelProfile is false.
+	SetAccelProfile(deviceID string, profile AccelProfile)
+
+	// SetNaturalScroll enables or disables natural (content-follows-
+	// finger) scroll direction for deviceID. No-op if deviceID's
+	// DeviceCaps.NaturalScroll is false.
+	SetNaturalScroll(deviceID string, enabled bool)
+
+	// SetLeftHanded swaps deviceID's primary and secondary buttons.
+	// No-op if deviceID's DeviceCaps.LeftHanded is false.
+	SetLeftHanded(deviceID string, enabled bool)
+
+	// SetMiddleButtonEmulation enables emulating a middle-button click
+	// via a simultaneous left+right press, for devices without a
+	// physical middle button. No-op if deviceID's
+	// DeviceCaps.MiddleButtonEmulation is false.
+	SetMiddleButtonEmulation(deviceID string, enabled bool)
+
+	// SetWheelEmulation enables emulating a scroll wheel from a
+	// two-finger vertical drag, for devices without a physical wheel.
+	// No-op if deviceID's DeviceCaps.WheelEmulation is false.
+	SetWheelEmulation(deviceID string, enabled bool)
+}
+
+// NullRawInputSource implements RawInputSource by reporting no devices
+// and ignoring all configuration calls. Used for testing and platforms
+// without raw input access.
+type NullRawInputSource struct{}
+
+// Devices returns nil.
+func (NullRawInputSource) Devices() []RawDevice { return nil }
+
+// OnRawPointer does nothing.
+func (NullRawInputSource) OnRawPointer(func(RawPointerEvent)) {}
+
+// SetAccelProfile does nothing.
+func (NullRawInputSource) SetAccelProfile(string, AccelProfile) {}
+
+// SetNaturalScroll does nothing.
+func (NullRawInputSource) SetNaturalScroll(string, bool) {}
+
+// SetLeftHanded does nothing.
+func (NullRawInputSource) SetLeftHanded(string, bool) {}
+
+// SetMiddleButtonEmulation does nothing.
+func (NullRawInputSource) SetMiddleButtonEmulation(string, bool) {}
+
+// SetWheelEmulation does nothing.
+func (NullRawInputSource) SetWheelEmulation(string, bool) {}
+
+// Ensure NullRawInputSource implements RawInputSource.
+var _ RawInputSource = NullRawInputSource{}
+
+// RawInputSources is the registry through which platform packages
+// (Linux libinput/evdev, Windows Raw Input) register their
+// RawInputSource backend, so gpucontext itself has no hard dependency on
+// any of them.
+//
+// Example:
+//
+//	gpucontext.RawInputSources.Register("libinput", func() gpucontext.RawInputSource {
+//	    return libinput.New()
+//	})
+var RawInputSources = NewRegistry[RawInputSource]()
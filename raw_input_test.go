@@ -0,0 +1,97 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "testing"
+
+func TestDeviceKind_String(t *testing.T) {
+	tests := []struct {
+		kind DeviceKind
+		want string
+	}{
+		{DeviceMouse, "Mouse"},
+		{DeviceTouchpad, "Touchpad"},
+		{DevicePen, "Pen"},
+		{DeviceTabletPad, "TabletPad"},
+		{DeviceKind(99), "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.kind.String(); got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccelProfile_String(t *testing.T) {
+	tests := []struct {
+		profile AccelProfile
+		want    string
+	}{
+		{AccelProfileAdaptive, "Adaptive"},
+		{AccelProfileFlat, "Flat"},
+		{AccelProfile(99), "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.profile.String(); got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRawPointerTool_String(t *testing.T) {
+	tests := []struct {
+		tool RawPointerTool
+		want string
+	}{
+		{RawPointerToolNone, "None"},
+		{RawPointerToolStylus, "Stylus"},
+		{RawPointerToolEraser, "Eraser"},
+		{RawPointerTool(99), "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.tool.String(); got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullRawInputSource(t *testing.T) {
+	var ris RawInputSource = NullRawInputSource{}
+
+	if devices := ris.Devices(); devices != nil {
+		t.Errorf("Devices() = %v, want nil", devices)
+	}
+
+	called := false
+	ris.OnRawPointer(func(RawPointerEvent) { called = true })
+	if called {
+		t.Error("NullRawInputSource should not call the callback")
+	}
+
+	// All configuration calls should be accepted without panic.
+	ris.SetAccelProfile("device-1", AccelProfileFlat)
+	ris.SetNaturalScroll("device-1", true)
+	ris.SetLeftHanded("device-1", true)
+	ris.SetMiddleButtonEmulation("device-1", true)
+	ris.SetWheelEmulation("device-1", true)
+
+	var _ RawInputSource = NullRawInputSource{}
+}
+
+func TestRawInputSources_Registry(t *testing.T) {
+	RawInputSources.Register("test-raw-input-backend", func() RawInputSource {
+		return NullRawInputSource{}
+	})
+	defer RawInputSources.Unregister("test-raw-input-backend")
+
+	if !RawInputSources.Has("test-raw-input-backend") {
+		t.Error("Has(test-raw-input-backend) = false after registration")
+	}
+}
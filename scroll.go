@@ -47,6 +47,21 @@ type ScrollEvent struct {
 	// DeltaMode indicates the unit of the delta values.
 	DeltaMode ScrollDeltaMode
 
+	// MomentumPhase reports where this event falls within the platform's
+	// scroll/momentum gesture lifecycle (macOS NSEvent phase, Wayland
+	// wl_pointer.axis_source/axis_stop, Windows Precision Touchpad),
+	// PhaseNone if the platform doesn't report one. Unlike FlingEstimator,
+	// which estimates momentum in software from a stream of raw deltas,
+	// MomentumPhase is authoritative: it lets a kinetic-scrolling overlay
+	// stop its own animation the instant a new gesture begins instead of
+	// guessing from dx/dy alone.
+	MomentumPhase ScrollMomentumPhase
+
+	// DeltaAdjustment requests or reports the platform's native
+	// shift-to-horizontalize behavior for this event. Defaults to
+	// DeltaAdjustmentNone.
+	DeltaAdjustment ScrollDeltaAdjustment
+
 	// Modifiers contains the keyboard modifier state at event time.
 	// Commonly used for Ctrl+scroll zoom behavior.
 	Modifiers Modifiers
@@ -57,6 +72,115 @@ type ScrollEvent struct {
 	Timestamp time.Duration
 }
 
+// ScrollMomentumPhase classifies a ScrollEvent within a platform-reported
+// scroll/momentum gesture, following Gecko's ScrollWheelInput momentum
+// phase model.
+type ScrollMomentumPhase uint8
+
+const (
+	// PhaseNone indicates the platform does not report momentum phases
+	// for this event.
+	PhaseNone ScrollMomentumPhase = iota
+
+	// PhaseMayBegin indicates fingers touched the trackpad but no
+	// scrolling has started yet.
+	PhaseMayBegin
+
+	// PhaseBegan indicates a user-driven scroll gesture has started.
+	PhaseBegan
+
+	// PhaseChanged indicates an ongoing user-driven scroll update.
+	PhaseChanged
+
+	// PhaseEnded indicates the user-driven gesture ended without
+	// transitioning into momentum scrolling.
+	PhaseEnded
+
+	// PhaseCancelled indicates the platform aborted the gesture.
+	PhaseCancelled
+
+	// PhaseMomentumBegan indicates the platform's own kinetic scrolling
+	// has started after the fingers lifted.
+	PhaseMomentumBegan
+
+	// PhaseMomentumChanged indicates an ongoing platform-driven momentum
+	// update.
+	PhaseMomentumChanged
+
+	// PhaseMomentumEnded indicates the platform's momentum scrolling has
+	// come to rest.
+	PhaseMomentumEnded
+)
+
+// String returns the momentum phase name for debugging.
+func (p ScrollMomentumPhase) String() string {
+	switch p {
+	case PhaseNone:
+		return "None"
+	case PhaseMayBegin:
+		return "MayBegin"
+	case PhaseBegan:
+		return "Began"
+	case PhaseChanged:
+		return "Changed"
+	case PhaseEnded:
+		return "Ended"
+	case PhaseCancelled:
+		return "Cancelled"
+	case PhaseMomentumBegan:
+		return "MomentumBegan"
+	case PhaseMomentumChanged:
+		return "MomentumChanged"
+	case PhaseMomentumEnded:
+		return "MomentumEnded"
+	default:
+		return "Unknown"
+	}
+}
+
+// ScrollDeltaAdjustment requests or reports a platform's native delta
+// adjustment strategy, following Gecko's WheelDeltaAdjustmentStrategy.
+// HorizontalizeIfShift is the traditional "Shift+wheel scrolls
+// horizontally" behavior; the AutoDir variants instead direct the delta
+// along whichever axis the scrolled content actually overflows.
+type ScrollDeltaAdjustment uint8
+
+const (
+	// DeltaAdjustmentNone requests no adjustment: DeltaX/DeltaY are
+	// delivered as the platform produced them.
+	DeltaAdjustmentNone ScrollDeltaAdjustment = iota
+
+	// DeltaAdjustmentHorizontalizeIfShift requests that a vertical delta
+	// be moved to DeltaX while the Shift modifier is held.
+	DeltaAdjustmentHorizontalizeIfShift
+
+	// DeltaAdjustmentAutoDir requests that the delta be redirected to
+	// whichever axis the scrolled element overflows, regardless of
+	// modifiers.
+	DeltaAdjustmentAutoDir
+
+	// DeltaAdjustmentAutoDirHonorRoot is like DeltaAdjustmentAutoDir, but
+	// falls back to the root/page scroll direction when the scrolled
+	// element doesn't overflow on either axis.
+	DeltaAdjustmentAutoDirHonorRoot
+)
+
+// String returns the delta adjustment strategy name for debugging.
+func (a ScrollDeltaAdjustment) String() string {
+	switch a {
+	case DeltaAdjustmentNone:
+		return "None"
+	case DeltaAdjustmentHorizontalizeIfShift:
+		return "HorizontalizeIfShift"
+	case DeltaAdjustmentAutoDir:
+		return "AutoDir"
+	case DeltaAdjustmentAutoDirHonorRoot:
+		return "AutoDirHonorRoot"
+	default:
+		return "Unknown"
+	}
+}
+
 // ScrollDeltaMode indicates the unit of scroll delta values.
 type ScrollDeltaMode uint8
 
@@ -0,0 +1,351 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import (
+	"math"
+	"time"
+)
+
+// ScrollAggregatorOption configures a ScrollAggregator constructed by
+// NewScrollAggregator.
+type ScrollAggregatorOption func(*scrollAggregatorConfig)
+
+type scrollAggregatorConfig struct {
+	minDelta    float64
+	idleTimeout time.Duration
+	lineHeight  float64
+	pageHeight  float64
+}
+
+// WithScrollMinDelta sets how many logical pixels of accumulated delta
+// must build up before ScrollAggregator.Feed emits a consolidated
+// ScrollEvent. Defaults to 1, so trackpad bursts of sub-pixel deltas are
+// combined into whole-pixel events instead of one event per frame.
+func WithScrollMinDelta(px float64) ScrollAggregatorOption {
+	return func(c *scrollAggregatorConfig) { c.minDelta = px }
+}
+
+// WithScrollIdleTimeout sets how long an accumulated delta below
+// MinDelta waits, with no further input, before ScrollAggregator.Tick
+// flushes it anyway. Defaults to 50ms.
+func WithScrollIdleTimeout(d time.Duration) ScrollAggregatorOption {
+	return func(c *scrollAggregatorConfig) { c.idleTimeout = d }
+}
+
+// WithScrollLineHeight sets the logical pixel height of one
+// ScrollDeltaLine unit, used by ScrollAggregator.ToPixels. Defaults to
+// 16.
+func WithScrollLineHeight(px float64) ScrollAggregatorOption {
+	return func(c *scrollAggregatorConfig) { c.lineHeight = px }
+}
+
+// WithScrollPageHeight sets the logical pixel height of one
+// ScrollDeltaPage unit, used by ScrollAggregator.ToPixels. Defaults to
+// 800.
+func WithScrollPageHeight(px float64) ScrollAggregatorOption {
+	return func(c *scrollAggregatorConfig) { c.pageHeight = px }
+}
+
+// ScrollAggregator consolidates a burst of raw ScrollEvents - sub-pixel
+// trackpad deltas, or 120-unit-notch mouse wheel deltas - into
+// ScrollDeltaPixel ScrollEvents a consumer can treat uniformly. Feed
+// every raw ScrollEvent to it and use its output instead; call Tick once
+// per frame so a burst that never crosses MinDelta still flushes after a
+// short idle period.
+//
+// ScrollAggregator resets its accumulator when the keyboard modifiers
+// change or the scroll direction reverses, so (for example) a Ctrl+scroll
+// zoom gesture doesn't inherit leftover delta from a preceding plain
+// scroll. ScrollAggregator is not safe for concurrent use.
+type ScrollAggregator struct {
+	minDelta    float64
+	idleTimeout time.Duration
+	lineHeight  float64
+	pageHeight  float64
+
+	haveAccum     bool
+	accX, accY    float64
+	x, y          float64
+	modifiers     Modifiers
+	lastTimestamp time.Duration
+}
+
+// NewScrollAggregator creates a ScrollAggregator with the default
+// thresholds described on the With* options.
+func NewScrollAggregator(opts ...ScrollAggregatorOption) *ScrollAggregator {
+	cfg := &scrollAggregatorConfig{
+		minDelta:    1,
+		idleTimeout: 50 * time.Millisecond,
+		lineHeight:  16,
+		pageHeight:  800,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return &ScrollAggregator{
+		minDelta:    cfg.minDelta,
+		idleTimeout: cfg.idleTimeout,
+		lineHeight:  cfg.lineHeight,
+		pageHeight:  cfg.pageHeight,
+	}
+}
+
+// ToPixels converts delta from mode's unit to logical pixels, using the
+// aggregator's configured line/page height for ScrollDeltaLine and
+// ScrollDeltaPage.
+func (a *ScrollAggregator) ToPixels(delta float64, mode ScrollDeltaMode) float64 {
+	switch mode {
+	case ScrollDeltaLine:
+		return delta * a.lineHeight
+	case ScrollDeltaPage:
+		return delta * a.pageHeight
+	default:
+		return delta
+	}
+}
+
+// Feed accumulates one raw ScrollEvent. It returns a consolidated,
+// ScrollDeltaPixel ScrollEvent and ok true when the accumulated delta
+// crosses MinDelta, or when a modifier change or direction reversal
+// flushes the previous burst early; otherwise ok is false and ev has
+// been absorbed into the accumulator. Call Tick to flush a burst that
+// never crosses MinDelta.
+func (a *ScrollAggregator) Feed(ev ScrollEvent) (ScrollEvent, bool) {
+	dx := a.ToPixels(ev.DeltaX, ev.DeltaMode)
+	dy := a.ToPixels(ev.DeltaY, ev.DeltaMode)
+
+	var flushed ScrollEvent
+	var didFlush bool
+	if a.haveAccum && (ev.Modifiers != a.modifiers || reversed(a.accX, dx) || reversed(a.accY, dy)) {
+		flushed, didFlush = a.flush(ev.Timestamp)
+	}
+
+	if !a.haveAccum {
+		a.start(ev, dx, dy)
+	} else {
+		a.accX += dx
+		a.accY += dy
+		a.x, a.y = ev.X, ev.Y
+		a.lastTimestamp = ev.Timestamp
+	}
+
+	if didFlush {
+		return flushed, true
+	}
+	if math.Abs(a.accX) >= a.minDelta || math.Abs(a.accY) >= a.minDelta {
+		return a.flush(ev.Timestamp)
+	}
+	return ScrollEvent{}, false
+}
+
+// Tick flushes any accumulated delta that has been idle for at least the
+// configured idle timeout. Call once per frame with the current time,
+// using the same reference as the timestamps passed to Feed.
+func (a *ScrollAggregator) Tick(now time.Duration) (ScrollEvent, bool) {
+	if !a.haveAccum || now-a.lastTimestamp < a.idleTimeout {
+		return ScrollEvent{}, false
+	}
+	return a.flush(now)
+}
+
+func (a *ScrollAggregator) start(ev ScrollEvent, dx, dy float64) {
+	a.haveAccum = true
+	a.accX, a.accY = dx, dy
+	a.x, a.y = ev.X, ev.Y
+	a.modifiers = ev.Modifiers
+	a.lastTimestamp = ev.Timestamp
+}
+
+func (a *ScrollAggregator) flush(ts time.Duration) (ScrollEvent, bool) {
+	if !a.haveAccum || (a.accX == 0 && a.accY == 0) {
+		a.haveAccum = false
+		return ScrollEvent{}, false
+	}
+	out := ScrollEvent{
+		X:         a.x,
+		Y:         a.y,
+		DeltaX:    a.accX,
+		DeltaY:    a.accY,
+		DeltaMode: ScrollDeltaPixel,
+		Modifiers: a.modifiers,
+		Timestamp: ts,
+	}
+	a.accX, a.accY = 0, 0
+	a.haveAccum = false
+	return out, true
+}
+
+// reversed reports whether delta is nonzero and opposite in sign to the
+// already-accumulated acc.
+func reversed(acc, delta float64) bool {
+	return acc != 0 && delta != 0 && (acc > 0) != (delta > 0)
+}
+
+// FlingEstimatorOption configures a FlingEstimator constructed by
+// NewFlingEstimator.
+type FlingEstimatorOption func(*flingEstimatorConfig)
+
+type flingEstimatorConfig struct {
+	sampleWindow time.Duration
+	friction     float64
+	minVelocity  float64
+}
+
+// WithFlingSampleWindow sets how far back FlingEstimator looks when
+// estimating release velocity in End; samples older than this relative
+// to the most recent Feed are discarded. Defaults to 100ms.
+func WithFlingSampleWindow(d time.Duration) FlingEstimatorOption {
+	return func(c *flingEstimatorConfig) { c.sampleWindow = d }
+}
+
+// WithFlingFriction sets the exponential-decay friction coefficient μ
+// used by Tick's velocity update (v *= exp(-μ*dt)). Higher values stop
+// momentum scrolling sooner. Defaults to 3.
+func WithFlingFriction(mu float64) FlingEstimatorOption {
+	return func(c *flingEstimatorConfig) { c.friction = mu }
+}
+
+// WithFlingMinVelocity sets the speed, in logical pixels per second,
+// below which End refuses to start a fling and Tick reports done.
+// Defaults to 20.
+func WithFlingMinVelocity(pxPerSecond float64) FlingEstimatorOption {
+	return func(c *flingEstimatorConfig) { c.minVelocity = pxPerSecond }
+}
+
+type flingDeltaSample struct {
+	dx, dy float64
+	ts     time.Duration
+}
+
+// FlingEstimator estimates release velocity from a rolling window of
+// recent scroll deltas and drives exponentially-decaying momentum
+// scrolling from it. Feed every consolidated scroll delta to it (for
+// example, ScrollAggregator's output) as it arrives, call End when
+// scrolling input stops, and then call Tick once per frame until it
+// reports done:
+//
+//	x, y := 0.0, 0.0
+//	for {
+//	    dx, dy, done := fling.Tick(now)
+//	    x, y = x+dx, y+dy
+//	    if done {
+//	        break
+//	    }
+//	}
+//
+// FlingEstimator is not safe for concurrent use.
+type FlingEstimator struct {
+	sampleWindow time.Duration
+	friction     float64
+	minVelocity  float64
+
+	samples []flingDeltaSample
+
+	active   bool
+	vx, vy   float64
+	lastTick time.Duration
+}
+
+// NewFlingEstimator creates a FlingEstimator with the default thresholds
+// described on the With* options.
+func NewFlingEstimator(opts ...FlingEstimatorOption) *FlingEstimator {
+	cfg := &flingEstimatorConfig{
+		sampleWindow: 100 * time.Millisecond,
+		friction:     3,
+		minVelocity:  20,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return &FlingEstimator{
+		sampleWindow: cfg.sampleWindow,
+		friction:     cfg.friction,
+		minVelocity:  cfg.minVelocity,
+	}
+}
+
+// Feed records one scroll delta sample at ts, for velocity estimation
+// when End is next called.
+func (f *FlingEstimator) Feed(dx, dy float64, ts time.Duration) {
+	f.samples = append(f.samples, flingDeltaSample{dx: dx, dy: dy, ts: ts})
+
+	cutoff := ts - f.sampleWindow
+	for i, s := range f.samples {
+		if s.ts >= cutoff {
+			f.samples = f.samples[i:]
+			return
+		}
+	}
+}
+
+// End estimates v0 from the deltas recorded since the start of the
+// rolling window and, if it exceeds MinVelocity, starts a momentum fling
+// driven by subsequent Tick calls. It returns whether a fling was
+// started; if not, any previously active fling is stopped.
+func (f *FlingEstimator) End() bool {
+	if len(f.samples) < 2 {
+		f.active = false
+		return false
+	}
+
+	first, last := f.samples[0], f.samples[len(f.samples)-1]
+	dt := (last.ts - first.ts).Seconds()
+	if dt <= 0 {
+		f.active = false
+		return false
+	}
+
+	var sumDX, sumDY float64
+	for _, s := range f.samples[1:] {
+		sumDX += s.dx
+		sumDY += s.dy
+	}
+	vx, vy := sumDX/dt, sumDY/dt
+
+	if math.Hypot(vx, vy) < f.minVelocity {
+		f.active = false
+		return false
+	}
+
+	f.vx, f.vy = vx, vy
+	f.lastTick = last.ts
+	f.active = true
+	return true
+}
+
+// Reset discards recorded samples and stops any active fling.
+func (f *FlingEstimator) Reset() {
+	f.samples = f.samples[:0]
+	f.active = false
+}
+
+// Tick advances the momentum-scrolling physics by dt = now - (the last
+// Tick call, or the time passed to End). It returns the position delta
+// to apply this frame and whether the fling has ended (velocity decayed
+// below MinVelocity, or no fling is active).
+func (f *FlingEstimator) Tick(now time.Duration) (dx, dy float64, done bool) {
+	if !f.active {
+		return 0, 0, true
+	}
+
+	dt := (now - f.lastTick).Seconds()
+	if dt <= 0 {
+		return 0, 0, false
+	}
+	f.lastTick = now
+
+	dx, dy = f.vx*dt, f.vy*dt
+	decay := math.Exp(-f.friction * dt)
+	f.vx *= decay
+	f.vy *= decay
+
+	if math.Hypot(f.vx, f.vy) < f.minVelocity {
+		f.active = false
+		return dx, dy, true
+	}
+	return dx, dy, false
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScrollAggregator_ToPixels(t *testing.T) {
+	a := NewScrollAggregator(WithScrollLineHeight(16), WithScrollPageHeight(800))
+
+	if got := a.ToPixels(2, ScrollDeltaPixel); got != 2 {
+		t.Errorf("Pixel: got %f, want 2", got)
+	}
+	if got := a.ToPixels(2, ScrollDeltaLine); got != 32 {
+		t.Errorf("Line: got %f, want 32", got)
+	}
+	if got := a.ToPixels(1, ScrollDeltaPage); got != 800 {
+		t.Errorf("Page: got %f, want 800", got)
+	}
+}
+
+func TestScrollAggregator_AccumulatesBelowMinDelta(t *testing.T) {
+	a := NewScrollAggregator(WithScrollMinDelta(5))
+
+	_, ok := a.Feed(ScrollEvent{DeltaY: 1, DeltaMode: ScrollDeltaPixel, Timestamp: 0})
+	if ok {
+		t.Fatal("first sub-threshold sample should not emit")
+	}
+	_, ok = a.Feed(ScrollEvent{DeltaY: 1, DeltaMode: ScrollDeltaPixel, Timestamp: time.Millisecond})
+	if ok {
+		t.Fatal("second sub-threshold sample should not emit")
+	}
+
+	out, ok := a.Feed(ScrollEvent{DeltaY: 4, DeltaMode: ScrollDeltaPixel, Timestamp: 2 * time.Millisecond})
+	if !ok {
+		t.Fatal("crossing MinDelta should emit")
+	}
+	if out.DeltaY != 6 || out.DeltaMode != ScrollDeltaPixel {
+		t.Errorf("out = %+v, want DeltaY=6 DeltaMode=Pixel", out)
+	}
+}
+
+func TestScrollAggregator_ConvertsLineDeltas(t *testing.T) {
+	a := NewScrollAggregator(WithScrollMinDelta(1), WithScrollLineHeight(10))
+
+	out, ok := a.Feed(ScrollEvent{DeltaY: 1, DeltaMode: ScrollDeltaLine, Timestamp: 0})
+	if !ok || out.DeltaY != 10 {
+		t.Errorf("out = %+v, ok = %v, want DeltaY=10", out, ok)
+	}
+}
+
+func TestScrollAggregator_ModifierChangeFlushesEarly(t *testing.T) {
+	a := NewScrollAggregator(WithScrollMinDelta(100))
+
+	a.Feed(ScrollEvent{DeltaY: 1, Timestamp: 0})
+	out, ok := a.Feed(ScrollEvent{DeltaY: 1, Modifiers: ModControl, Timestamp: time.Millisecond})
+	if !ok {
+		t.Fatal("modifier change should flush the previous burst")
+	}
+	if out.DeltaY != 1 {
+		t.Errorf("flushed DeltaY = %f, want 1 (only the pre-change burst)", out.DeltaY)
+	}
+}
+
+func TestScrollAggregator_DirectionReversalFlushesEarly(t *testing.T) {
+	a := NewScrollAggregator(WithScrollMinDelta(100))
+
+	a.Feed(ScrollEvent{DeltaY: 5, Timestamp: 0})
+	out, ok := a.Feed(ScrollEvent{DeltaY: -5, Timestamp: time.Millisecond})
+	if !ok {
+		t.Fatal("direction reversal should flush the previous burst")
+	}
+	if out.DeltaY != 5 {
+		t.Errorf("flushed DeltaY = %f, want 5", out.DeltaY)
+	}
+}
+
+func TestScrollAggregator_TickFlushesOnIdle(t *testing.T) {
+	a := NewScrollAggregator(WithScrollMinDelta(100), WithScrollIdleTimeout(50*time.Millisecond))
+
+	a.Feed(ScrollEvent{DeltaY: 3, Timestamp: 0})
+
+	if _, ok := a.Tick(10 * time.Millisecond); ok {
+		t.Error("Tick before idle timeout should not flush")
+	}
+	out, ok := a.Tick(60 * time.Millisecond)
+	if !ok || out.DeltaY != 3 {
+		t.Errorf("out = %+v, ok = %v, want DeltaY=3", out, ok)
+	}
+
+	// Nothing left to flush.
+	if _, ok := a.Tick(200 * time.Millisecond); ok {
+		t.Error("Tick with no accumulated delta should not flush")
+	}
+}
+
+func TestFlingEstimator_EndStartsFlingAboveMinVelocity(t *testing.T) {
+	f := NewFlingEstimator(WithFlingMinVelocity(10))
+
+	f.Feed(10, 0, 0)
+	f.Feed(10, 0, 10*time.Millisecond)
+	f.Feed(10, 0, 20*time.Millisecond)
+
+	if !f.End() {
+		t.Fatal("End() = false, want true for a fast release")
+	}
+}
+
+func TestFlingEstimator_EndIgnoresSlowRelease(t *testing.T) {
+	f := NewFlingEstimator(WithFlingMinVelocity(1000))
+
+	f.Feed(1, 0, 0)
+	f.Feed(1, 0, 50*time.Millisecond)
+
+	if f.End() {
+		t.Error("End() = true, want false for a slow release")
+	}
+}
+
+func TestFlingEstimator_TickDecaysAndTerminates(t *testing.T) {
+	f := NewFlingEstimator(WithFlingFriction(3), WithFlingMinVelocity(5))
+
+	f.Feed(1000, 0, 0)
+	f.Feed(1000, 0, 10*time.Millisecond)
+	if !f.End() {
+		t.Fatal("End() = false, want true")
+	}
+
+	var lastT time.Duration
+	done := false
+	for i := 1; i <= 5000 && !done; i++ {
+		now := 10*time.Millisecond + time.Duration(i)*16*time.Millisecond
+		dx, _, d := f.Tick(now)
+		if dx < 0 {
+			t.Errorf("dx went negative at tick %d: %f", i, dx)
+		}
+		done = d
+		lastT = now
+	}
+	if !done {
+		t.Fatalf("fling never terminated by t=%v", lastT)
+	}
+}
+
+func TestFlingEstimator_TickWithoutActiveFlingIsDone(t *testing.T) {
+	f := NewFlingEstimator()
+
+	dx, dy, done := f.Tick(time.Second)
+	if dx != 0 || dy != 0 || !done {
+		t.Errorf("Tick with no active fling = (%f, %f, %v), want (0, 0, true)", dx, dy, done)
+	}
+}
+
+func TestFlingEstimator_Reset(t *testing.T) {
+	f := NewFlingEstimator(WithFlingMinVelocity(10))
+
+	f.Feed(1000, 0, 0)
+	f.Feed(1000, 0, 10*time.Millisecond)
+	f.End()
+	f.Reset()
+
+	if f.active {
+		t.Error("active should be false after Reset")
+	}
+	if f.End() {
+		t.Error("End() after Reset with no samples should return false")
+	}
+}
+
+func TestReversed(t *testing.T) {
+	tests := []struct {
+		acc, delta float64
+		want       bool
+	}{
+		{1, -1, true},
+		{1, 1, false},
+		{0, -1, false},
+		{1, 0, false},
+	}
+	for _, tt := range tests {
+		if got := reversed(tt.acc, tt.delta); got != tt.want {
+			t.Errorf("reversed(%f, %f) = %v, want %v", tt.acc, tt.delta, got, tt.want)
+		}
+	}
+}
+
+func TestFlingEstimator_ZeroDtTickIsNoop(t *testing.T) {
+	f := NewFlingEstimator(WithFlingMinVelocity(10))
+	f.Feed(1000, 0, 0)
+	f.Feed(1000, 0, 10*time.Millisecond)
+	f.End()
+
+	dx, dy, done := f.Tick(10 * time.Millisecond)
+	if dx != 0 || dy != 0 || done {
+		t.Errorf("zero-dt Tick = (%f, %f, %v), want (0, 0, false)", dx, dy, done)
+	}
+}
+
+func TestScrollAggregator_NoEmitWithNoInput(t *testing.T) {
+	a := NewScrollAggregator()
+	if _, ok := a.Tick(time.Hour); ok {
+		t.Error("Tick with no prior Feed should not emit")
+	}
+}
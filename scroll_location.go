@@ -0,0 +1,142 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "time"
+
+// ScrollLocationKind classifies a ScrollLocation.
+type ScrollLocationKind uint8
+
+const (
+	// ScrollByDelta indicates a relative scroll by DeltaX/DeltaY,
+	// interpreted according to DeltaMode - the same shape as ScrollEvent,
+	// generated by a wheel, touchpad, or PageUp/PageDown.
+	ScrollByDelta ScrollLocationKind = iota
+
+	// ScrollToStart indicates a jump to the start of scrollable content
+	// (Home key), ignoring DeltaX/DeltaY/DeltaMode.
+	ScrollToStart
+
+	// ScrollToEnd indicates a jump to the end of scrollable content (End
+	// key), ignoring DeltaX/DeltaY/DeltaMode.
+	ScrollToEnd
+)
+
+// String returns the scroll location kind name for debugging.
+func (k ScrollLocationKind) String() string {
+	switch k {
+	case ScrollByDelta:
+		return "ByDelta"
+	case ScrollToStart:
+		return "ToStart"
+	case ScrollToEnd:
+		return "ToEnd"
+	default:
+		return "Unknown"
+	}
+}
+
+// ScrollLocation represents a scroll intent: either a relative delta
+// (wheel, touchpad, or page jump) or an absolute jump to the start or end
+// of scrollable content, following the Servo ScrollLocation refactor.
+// Unifying these into one type lets a scrollable widget react to wheel
+// input and keyboard navigation (Home/End/PageUp/PageDown) with a single
+// handler instead of separately translating keys to pixel deltas.
+type ScrollLocation struct {
+	// Kind discriminates which fields below are meaningful.
+	Kind ScrollLocationKind
+
+	// DeltaX and DeltaY are the scroll amount when Kind is ScrollByDelta.
+	// The unit depends on DeltaMode. Zero for ScrollToStart/ScrollToEnd.
+	DeltaX float64
+	DeltaY float64
+
+	// DeltaMode indicates the unit of DeltaX/DeltaY when Kind is
+	// ScrollByDelta.
+	DeltaMode ScrollDeltaMode
+}
+
+// ToPixels converts a ScrollByDelta location to logical pixels, using
+// lineHeight and pageHeight for ScrollDeltaLine and ScrollDeltaPage (see
+// ScrollAggregator.ToPixels for the same conversion applied to ScrollEvent).
+// ok is false for ScrollToStart/ScrollToEnd, which have no delta to convert.
+func (l ScrollLocation) ToPixels(lineHeight, pageHeight float64) (dx, dy float64, ok bool) {
+	if l.Kind != ScrollByDelta {
+		return 0, 0, false
+	}
+	switch l.DeltaMode {
+	case ScrollDeltaLine:
+		return l.DeltaX * lineHeight, l.DeltaY * lineHeight, true
+	case ScrollDeltaPage:
+		return l.DeltaX * pageHeight, l.DeltaY * pageHeight, true
+	default:
+		return l.DeltaX, l.DeltaY, true
+	}
+}
+
+// KeyToScrollLocation translates Home, End, PageUp, and PageDown into the
+// ScrollLocation they represent, for backends that implement
+// ScrollRequestEventSource by watching EventSource.OnKey. ok is false for
+// any other key.
+func KeyToScrollLocation(key Key) (loc ScrollLocation, ok bool) {
+	switch key {
+	case KeyHome:
+		return ScrollLocation{Kind: ScrollToStart}, true
+	case KeyEnd:
+		return ScrollLocation{Kind: ScrollToEnd}, true
+	case KeyPageUp:
+		return ScrollLocation{Kind: ScrollByDelta, DeltaY: -1, DeltaMode: ScrollDeltaPage}, true
+	case KeyPageDown:
+		return ScrollLocation{Kind: ScrollByDelta, DeltaY: 1, DeltaMode: ScrollDeltaPage}, true
+	default:
+		return ScrollLocation{}, false
+	}
+}
+
+// ScrollRequestEvent reports a scroll intent from any source - wheel,
+// touchpad, or keyboard navigation - as a single uniform ScrollLocation,
+// so a scrollable widget can implement one handler instead of separately
+// reacting to ScrollEvent and Home/End/PageUp/PageDown key presses.
+type ScrollRequestEvent struct {
+	// Location is the requested scroll intent.
+	Location ScrollLocation
+
+	// Modifiers contains the keyboard modifier state at event time.
+	Modifiers Modifiers
+
+	// Timestamp is the event time as duration since an arbitrary
+	// reference. Zero if timestamps are not available.
+	Timestamp time.Duration
+}
+
+// ScrollRequestEventSource is an optional capability of platforms that
+// unify wheel/touchpad scrolling with Home/End/PageUp/PageDown keyboard
+// navigation into a single ScrollRequestEvent stream (see
+// KeyToScrollLocation for how a backend derives one from the other).
+//
+// ScrollRequestEventSource is optional. Use type assertion to check
+// availability:
+//
+//	if sres, ok := eventSource.(gpucontext.ScrollRequestEventSource); ok {
+//	    sres.OnScrollRequest(handleScrollRequest)
+//	}
+type ScrollRequestEventSource interface {
+	// OnScrollRequest registers a callback for unified scroll intent
+	// events.
+	//
+	// Callback threading: Called on the main/UI thread.
+	// Callbacks should be fast and non-blocking.
+	OnScrollRequest(fn func(ScrollRequestEvent))
+}
+
+// NullScrollRequestEventSource implements ScrollRequestEventSource by
+// ignoring all registrations. Useful for platforms or configurations
+// where unified scroll requests are not available.
+type NullScrollRequestEventSource struct{}
+
+// OnScrollRequest does nothing.
+func (NullScrollRequestEventSource) OnScrollRequest(func(ScrollRequestEvent)) {}
+
+// Ensure NullScrollRequestEventSource implements ScrollRequestEventSource.
+var _ ScrollRequestEventSource = NullScrollRequestEventSource{}
@@ -0,0 +1,101 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "testing"
+
+func TestScrollLocationKind_String(t *testing.T) {
+	tests := []struct {
+		kind ScrollLocationKind
+		want string
+	}{
+		{ScrollByDelta, "ByDelta"},
+		{ScrollToStart, "ToStart"},
+		{ScrollToEnd, "ToEnd"},
+		{ScrollLocationKind(99), "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.kind.String(); got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScrollLocation_ToPixels(t *testing.T) {
+	t.Run("pixel delta passes through", func(t *testing.T) {
+		loc := ScrollLocation{Kind: ScrollByDelta, DeltaX: 3, DeltaY: -4, DeltaMode: ScrollDeltaPixel}
+		dx, dy, ok := loc.ToPixels(16, 600)
+		if !ok || dx != 3 || dy != -4 {
+			t.Errorf("ToPixels(): got (%v, %v, %v), want (3, -4, true)", dx, dy, ok)
+		}
+	})
+
+	t.Run("line delta scales by lineHeight", func(t *testing.T) {
+		loc := ScrollLocation{Kind: ScrollByDelta, DeltaY: 2, DeltaMode: ScrollDeltaLine}
+		_, dy, ok := loc.ToPixels(16, 600)
+		if !ok || dy != 32 {
+			t.Errorf("ToPixels(): got (%v, %v), want 32", dy, ok)
+		}
+	})
+
+	t.Run("page delta scales by pageHeight", func(t *testing.T) {
+		loc := ScrollLocation{Kind: ScrollByDelta, DeltaY: 1, DeltaMode: ScrollDeltaPage}
+		_, dy, ok := loc.ToPixels(16, 600)
+		if !ok || dy != 600 {
+			t.Errorf("ToPixels(): got (%v, %v), want 600", dy, ok)
+		}
+	})
+
+	t.Run("ScrollToStart has no pixel delta", func(t *testing.T) {
+		_, _, ok := ScrollLocation{Kind: ScrollToStart}.ToPixels(16, 600)
+		if ok {
+			t.Error("ToPixels(): ScrollToStart should return ok=false")
+		}
+	})
+
+	t.Run("ScrollToEnd has no pixel delta", func(t *testing.T) {
+		_, _, ok := ScrollLocation{Kind: ScrollToEnd}.ToPixels(16, 600)
+		if ok {
+			t.Error("ToPixels(): ScrollToEnd should return ok=false")
+		}
+	})
+}
+
+func TestKeyToScrollLocation(t *testing.T) {
+	tests := []struct {
+		name string
+		key  Key
+		want ScrollLocation
+		ok   bool
+	}{
+		{"Home", KeyHome, ScrollLocation{Kind: ScrollToStart}, true},
+		{"End", KeyEnd, ScrollLocation{Kind: ScrollToEnd}, true},
+		{"PageUp", KeyPageUp, ScrollLocation{Kind: ScrollByDelta, DeltaY: -1, DeltaMode: ScrollDeltaPage}, true},
+		{"PageDown", KeyPageDown, ScrollLocation{Kind: ScrollByDelta, DeltaY: 1, DeltaMode: ScrollDeltaPage}, true},
+		{"unrelated key", KeyA, ScrollLocation{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := KeyToScrollLocation(tt.key)
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("KeyToScrollLocation(%v): got (%+v, %v), want (%+v, %v)", tt.key, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestNullScrollRequestEventSource(t *testing.T) {
+	var s NullScrollRequestEventSource
+
+	called := false
+	s.OnScrollRequest(func(ScrollRequestEvent) { called = true })
+	if called {
+		t.Error("NullScrollRequestEventSource should not call the callback")
+	}
+
+	var _ ScrollRequestEventSource = NullScrollRequestEventSource{}
+	var _ ScrollRequestEventSource = s
+}
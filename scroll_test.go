@@ -59,6 +59,12 @@ func TestScrollEvent_ZeroValue(t *testing.T) {
 	if ev.DeltaMode != ScrollDeltaPixel {
 		t.Errorf("Zero value DeltaMode = %v, want ScrollDeltaPixel", ev.DeltaMode)
 	}
+	if ev.MomentumPhase != PhaseNone {
+		t.Errorf("Zero value MomentumPhase = %v, want PhaseNone", ev.MomentumPhase)
+	}
+	if ev.DeltaAdjustment != DeltaAdjustmentNone {
+		t.Errorf("Zero value DeltaAdjustment = %v, want DeltaAdjustmentNone", ev.DeltaAdjustment)
+	}
 	if ev.Modifiers != 0 {
 		t.Errorf("Zero value Modifiers = %d, want 0", ev.Modifiers)
 	}
@@ -67,6 +73,53 @@ func TestScrollEvent_ZeroValue(t *testing.T) {
 	}
 }
 
+func TestScrollMomentumPhase_String(t *testing.T) {
+	tests := []struct {
+		phase ScrollMomentumPhase
+		want  string
+	}{
+		{PhaseNone, "None"},
+		{PhaseMayBegin, "MayBegin"},
+		{PhaseBegan, "Began"},
+		{PhaseChanged, "Changed"},
+		{PhaseEnded, "Ended"},
+		{PhaseCancelled, "Cancelled"},
+		{PhaseMomentumBegan, "MomentumBegan"},
+		{PhaseMomentumChanged, "MomentumChanged"},
+		{PhaseMomentumEnded, "MomentumEnded"},
+		{ScrollMomentumPhase(99), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.phase.String(); got != tt.want {
+				t.Errorf("ScrollMomentumPhase(%d).String() = %q, want %q", tt.phase, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScrollDeltaAdjustment_String(t *testing.T) {
+	tests := []struct {
+		adj  ScrollDeltaAdjustment
+		want string
+	}{
+		{DeltaAdjustmentNone, "None"},
+		{DeltaAdjustmentHorizontalizeIfShift, "HorizontalizeIfShift"},
+		{DeltaAdjustmentAutoDir, "AutoDir"},
+		{DeltaAdjustmentAutoDirHonorRoot, "AutoDirHonorRoot"},
+		{ScrollDeltaAdjustment(99), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.adj.String(); got != tt.want {
+				t.Errorf("ScrollDeltaAdjustment(%d).String() = %q, want %q", tt.adj, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestScrollEvent_FullConstruction(t *testing.T) {
 	ev := ScrollEvent{
 		X:         100.5,
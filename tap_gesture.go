@@ -0,0 +1,94 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "time"
+
+// TapGestureKind classifies a TapGestureEvent.
+type TapGestureKind uint8
+
+const (
+	// TapGestureSingleTap indicates a completed single tap.
+	TapGestureSingleTap TapGestureKind = iota
+
+	// TapGestureDoubleTap indicates a completed double tap.
+	TapGestureDoubleTap
+
+	// TapGestureLongTap indicates a press has stayed down long enough to
+	// count as a long tap (finger still down).
+	TapGestureLongTap
+
+	// TapGestureLongTapUp indicates the finger lifted after a
+	// TapGestureLongTap was reported.
+	TapGestureLongTapUp
+
+	// TapGestureCancel indicates an in-progress tap was aborted by the
+	// platform (for example, the finger moved too far, or a system
+	// gesture took over).
+	TapGestureCancel
+)
+
+// String returns the tap gesture kind name for debugging.
+func (k TapGestureKind) String() string {
+	switch k {
+	case TapGestureSingleTap:
+		return "SingleTap"
+	case TapGestureDoubleTap:
+		return "DoubleTap"
+	case TapGestureLongTap:
+		return "LongTap"
+	case TapGestureLongTapUp:
+		return "LongTapUp"
+	case TapGestureCancel:
+		return "Cancel"
+	default:
+		return "Unknown"
+	}
+}
+
+// TapGestureEvent reports a native tap gesture, as delivered directly by
+// the platform rather than reconstructed from raw pointer or touch data.
+// Compare GestureRecognizer's GestureTap/GestureDoubleTap/GestureLongPress,
+// which synthesize the same distinctions from a PointerEventSource for
+// platforms that don't report tap gestures natively.
+type TapGestureEvent struct {
+	// Kind classifies this event.
+	Kind TapGestureKind
+
+	// Position is where the tap occurred, in logical pixels.
+	Position Point
+
+	// Timestamp is the event time as duration since an arbitrary
+	// reference. Zero if timestamps are not available.
+	Timestamp time.Duration
+}
+
+// TapGestureEventSource is an optional capability of platforms that
+// report native tap gestures directly, rather than requiring them to be
+// reconstructed from PointerEvent/TouchEvent.
+//
+// TapGestureEventSource is optional. Use type assertion to check
+// availability:
+//
+//	if tgs, ok := eventSource.(gpucontext.TapGestureEventSource); ok {
+//	    tgs.OnTapGesture(handleTapGesture)
+//	}
+type TapGestureEventSource interface {
+	// OnTapGesture registers a callback for native tap gestures.
+	//
+	// Callback threading: Called on the main/UI thread.
+	// Callbacks should be fast and non-blocking.
+	OnTapGesture(fn func(TapGestureEvent))
+}
+
+// NullTapGestureEventSource implements TapGestureEventSource by ignoring
+// all registrations. Useful for platforms or configurations where native
+// tap gestures are not available.
+type NullTapGestureEventSource struct{}
+
+// OnTapGesture does nothing.
+func (NullTapGestureEventSource) OnTapGesture(func(TapGestureEvent)) {}
+
+// Ensure NullTapGestureEventSource implements TapGestureEventSource.
+var _ TapGestureEventSource = NullTapGestureEventSource{}
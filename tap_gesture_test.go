@@ -0,0 +1,40 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "testing"
+
+func TestTapGestureKind_String(t *testing.T) {
+	tests := []struct {
+		kind TapGestureKind
+		want string
+	}{
+		{TapGestureSingleTap, "SingleTap"},
+		{TapGestureDoubleTap, "DoubleTap"},
+		{TapGestureLongTap, "LongTap"},
+		{TapGestureLongTapUp, "LongTapUp"},
+		{TapGestureCancel, "Cancel"},
+		{TapGestureKind(99), "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.kind.String(); got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullTapGestureEventSource(t *testing.T) {
+	var p NullTapGestureEventSource
+
+	called := false
+	p.OnTapGesture(func(TapGestureEvent) { called = true })
+	if called {
+		t.Error("NullTapGestureEventSource should not call the callback")
+	}
+
+	var _ TapGestureEventSource = NullTapGestureEventSource{}
+	var _ TapGestureEventSource = p
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "image"
+
+// TextCompositionEventKind classifies a TextCompositionEvent.
+type TextCompositionEventKind uint8
+
+const (
+	// TextCompositionPreedit indicates the IME composition string changed.
+	// Text is the current, not-yet-committed composition string.
+	TextCompositionPreedit TextCompositionEventKind = iota
+
+	// TextCompositionCommit indicates composed (or directly typed) text should
+	// be inserted at the cursor.
+	TextCompositionCommit
+
+	// TextCompositionDeleteSurrounding indicates the IME is requesting deletion
+	// of text surrounding the cursor, before the next Commit/Preedit
+	// (used by some CJK and predictive-text IMEs to correct earlier
+	// input).
+	TextCompositionDeleteSurrounding
+)
+
+// String returns the event kind name for debugging.
+func (k TextCompositionEventKind) String() string {
+	switch k {
+	case TextCompositionPreedit:
+		return "Preedit"
+	case TextCompositionCommit:
+		return "Commit"
+	case TextCompositionDeleteSurrounding:
+		return "DeleteSurrounding"
+	default:
+		return "Unknown"
+	}
+}
+
+// TextCompositionEvent represents one step of IME composition or commit.
+//
+// Field usage by Kind:
+//   - TextCompositionPreedit: Text, CursorStart, CursorEnd
+//   - TextCompositionCommit: Text
+//   - TextCompositionDeleteSurrounding: Before, After
+type TextCompositionEvent struct {
+	Kind TextCompositionEventKind
+
+	// Text is the composition (Preedit) or final (Commit) string.
+	Text string
+
+	// CursorStart and CursorEnd give the cursor/selection position
+	// within Text, in runes, for Preedit events.
+	CursorStart int
+	CursorEnd   int
+
+	// Before and After are the number of runes to delete immediately
+	// before and after the cursor, for DeleteSurrounding events.
+	Before int
+	After  int
+}
+
+// TextInputProvider exposes IME composition, pre-edit text, and
+// candidate-window positioning, for CJK input, emoji pickers, and voice
+// input accessibility tools.
+//
+// TextInputProvider is a richer, surrounding-text-aware alternative to
+// EventSource's OnIMECompositionStart/Update/End plus IMEController: where
+// IMEState/IMEController model composition as a single in-progress
+// string, TextInputProvider additionally lets the app hand the platform a
+// surrounding-text hint (SetTextInputContent) and reports mid-composition
+// deletions (TextCompositionDeleteSurrounding) separately from commits, matching
+// the text_input_v3 Wayland protocol and Android's InputConnection model.
+// An implementation may satisfy either, both, or neither.
+//
+// TextInputProvider is optional. Use type assertion to check availability:
+//
+//	if tp, ok := provider.(gpucontext.TextInputProvider); ok {
+//	    tp.SetTextInputEnabled(true)
+//	    tp.SetTextInputRect(cursorRect)
+//	}
+//
+// Since IME state is per-focused-widget, prefer driving a
+// TextInputProvider through TextInputController rather than calling it
+// directly from multiple widgets.
+type TextInputProvider interface {
+	// SetTextInputEnabled enables or disables IME composition for the
+	// current input context. Disable for password fields and other
+	// non-text inputs.
+	SetTextInputEnabled(enabled bool)
+
+	// SetTextInputRect tells the platform where the text cursor is, in
+	// physical pixels relative to the window, so it can position the IME
+	// candidate window.
+	SetTextInputRect(r image.Rectangle)
+
+	// SetTextInputContent gives the platform a surrounding-text hint:
+	// the text immediately before the selection, the selected text
+	// itself, and the text immediately after. Used by predictive and
+	// auto-correcting IMEs; pass empty strings if unavailable.
+	SetTextInputContent(before, selection, after string)
+
+	// OnTextInput registers a callback for composition and commit
+	// events.
+	//
+	// Callback threading: Called on the main/UI thread.
+	// Callbacks should be fast and non-blocking.
+	OnTextInput(fn func(TextCompositionEvent))
+}
+
+// NullTextInputProvider implements TextInputProvider with no-op behavior.
+// Used for testing and platforms without IME support.
+type NullTextInputProvider struct{}
+
+// SetTextInputEnabled does nothing.
+func (NullTextInputProvider) SetTextInputEnabled(bool) {}
+
+// SetTextInputRect does nothing.
+func (NullTextInputProvider) SetTextInputRect(image.Rectangle) {}
+
+// SetTextInputContent does nothing.
+func (NullTextInputProvider) SetTextInputContent(before, selection, after string) {}
+
+// OnTextInput does nothing.
+func (NullTextInputProvider) OnTextInput(func(TextCompositionEvent)) {}
+
+// Ensure NullTextInputProvider implements TextInputProvider.
+var _ TextInputProvider = NullTextInputProvider{}
+
+// TextInputTarget is implemented by a UI widget that can receive IME
+// focus, so TextInputController knows what rect and surrounding-text hint
+// to push to a TextInputProvider when the widget gains focus.
+type TextInputTarget interface {
+	// TextInputRect returns the widget's text cursor rect, in physical
+	// pixels relative to the window.
+	TextInputRect() image.Rectangle
+
+	// TextInputSurroundingText returns the text immediately before the
+	// selection, the selected text, and the text immediately after.
+	TextInputSurroundingText() (before, selection, after string)
+}
+
+// TextInputController tracks which TextInputTarget currently has IME
+// focus and forwards enable/rect/content updates to a TextInputProvider,
+// so UI frameworks only need to call Focus/Blur as focus moves between
+// widgets rather than driving TextInputProvider directly from each one.
+type TextInputController struct {
+	provider TextInputProvider
+	focused  TextInputTarget
+}
+
+// NewTextInputController creates a TextInputController that drives
+// provider as focus moves between targets passed to Focus.
+func NewTextInputController(provider TextInputProvider) *TextInputController {
+	return &TextInputController{provider: provider}
+}
+
+// Focus gives target IME focus: enables text input and pushes its rect
+// and surrounding-text hint to the provider. Any previously focused
+// target is implicitly blurred first.
+func (c *TextInputController) Focus(target TextInputTarget) {
+	c.focused = target
+	c.provider.SetTextInputEnabled(true)
+	c.pushContent()
+}
+
+// Blur removes IME focus from the currently focused target, if any, and
+// disables text input. Does nothing if no target is focused.
+func (c *TextInputController) Blur() {
+	if c.focused == nil {
+		return
+	}
+	c.focused = nil
+	c.provider.SetTextInputEnabled(false)
+}
+
+// Focused returns the currently focused target, or nil if none.
+func (c *TextInputController) Focused() TextInputTarget {
+	return c.focused
+}
+
+// Update re-pushes the focused target's rect and surrounding-text hint to
+// the provider. Call after the focused target's cursor moves or its
+// content changes without a focus transition. Does nothing if no target
+// is focused.
+func (c *TextInputController) Update() {
+	if c.focused == nil {
+		return
+	}
+	c.pushContent()
+}
+
+func (c *TextInputController) pushContent() {
+	c.provider.SetTextInputRect(c.focused.TextInputRect())
+	before, selection, after := c.focused.TextInputSurroundingText()
+	c.provider.SetTextInputContent(before, selection, after)
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import (
+	"image"
+	"testing"
+)
+
+func TestTextCompositionEventKind_String(t *testing.T) {
+	tests := []struct {
+		kind TextCompositionEventKind
+		want string
+	}{
+		{TextCompositionPreedit, "Preedit"},
+		{TextCompositionCommit, "Commit"},
+		{TextCompositionDeleteSurrounding, "DeleteSurrounding"},
+		{TextCompositionEventKind(99), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := tt.kind.String()
+			if got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullTextInputProvider(t *testing.T) {
+	var tp TextInputProvider = NullTextInputProvider{}
+
+	// All calls should be accepted without panic.
+	tp.SetTextInputEnabled(true)
+	tp.SetTextInputRect(image.Rect(0, 0, 10, 20))
+	tp.SetTextInputContent("hello ", "world", "!")
+
+	called := false
+	tp.OnTextInput(func(TextCompositionEvent) { called = true })
+	if called {
+		t.Error("NullTextInputProvider should not call the callback")
+	}
+
+	var _ TextInputProvider = NullTextInputProvider{}
+}
+
+type fakeTextInputProvider struct {
+	enabled   bool
+	rect      image.Rectangle
+	before    string
+	selection string
+	after     string
+}
+
+func (p *fakeTextInputProvider) SetTextInputEnabled(enabled bool)   { p.enabled = enabled }
+func (p *fakeTextInputProvider) SetTextInputRect(r image.Rectangle) { p.rect = r }
+func (p *fakeTextInputProvider) SetTextInputContent(before, selection, after string) {
+	p.before, p.selection, p.after = before, selection, after
+}
+func (p *fakeTextInputProvider) OnTextInput(func(TextCompositionEvent)) {}
+
+type fakeTextInputTarget struct {
+	rect               image.Rectangle
+	before, sel, after string
+}
+
+func (w fakeTextInputTarget) TextInputRect() image.Rectangle { return w.rect }
+func (w fakeTextInputTarget) TextInputSurroundingText() (before, selection, after string) {
+	return w.before, w.sel, w.after
+}
+
+func TestTextInputController_FocusPushesContent(t *testing.T) {
+	provider := &fakeTextInputProvider{}
+	c := NewTextInputController(provider)
+
+	target := fakeTextInputTarget{
+		rect:   image.Rect(10, 20, 110, 40),
+		before: "hello ",
+		sel:    "world",
+		after:  "!",
+	}
+	c.Focus(target)
+
+	if !provider.enabled {
+		t.Error("Focus did not enable text input")
+	}
+	if provider.rect != target.rect {
+		t.Errorf("rect = %v, want %v", provider.rect, target.rect)
+	}
+	if provider.before != "hello " || provider.selection != "world" || provider.after != "!" {
+		t.Errorf("content = %q/%q/%q, want %q/%q/%q", provider.before, provider.selection, provider.after, "hello ", "world", "!")
+	}
+	if c.Focused() != target {
+		t.Error("Focused() did not return the focused target")
+	}
+}
+
+func TestTextInputController_Blur(t *testing.T) {
+	provider := &fakeTextInputProvider{}
+	c := NewTextInputController(provider)
+
+	c.Focus(fakeTextInputTarget{})
+	c.Blur()
+
+	if provider.enabled {
+		t.Error("Blur did not disable text input")
+	}
+	if c.Focused() != nil {
+		t.Error("Focused() should be nil after Blur")
+	}
+
+	// Blur with nothing focused should be a no-op, not a panic.
+	c.Blur()
+}
+
+func TestTextInputController_Update(t *testing.T) {
+	provider := &fakeTextInputProvider{}
+	c := NewTextInputController(provider)
+
+	// Update with nothing focused should be a no-op, not a panic.
+	c.Update()
+
+	target := fakeTextInputTarget{rect: image.Rect(0, 0, 50, 20), before: "a", sel: "b", after: "c"}
+	c.Focus(target)
+
+	moved := fakeTextInputTarget{rect: image.Rect(0, 20, 50, 40), before: "x", sel: "y", after: "z"}
+	c.focused = moved
+	c.Update()
+
+	if provider.rect != moved.rect {
+		t.Errorf("rect after Update = %v, want %v", provider.rect, moved.rect)
+	}
+	if provider.before != "x" || provider.selection != "y" || provider.after != "z" {
+		t.Errorf("content after Update = %q/%q/%q, want x/y/z", provider.before, provider.selection, provider.after)
+	}
+}
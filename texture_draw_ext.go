@@ -0,0 +1,116 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+// Color is a linear RGBA color with components normally in [0, 1].
+type Color struct {
+	R, G, B, A float32
+}
+
+// Transform2D is a 2x3 affine transform matrix, in row-major order:
+//
+//	[ A  B  Tx ]
+//	[ C  D  Ty ]
+//
+// applied to a point as x' = A*x + B*y + Tx, y' = C*x + D*y + Ty. The zero
+// value is NOT the identity transform (it collapses every point to the
+// origin); use IdentityTransform2D for an unscaled, unrotated draw.
+type Transform2D struct {
+	A, B, Tx float32
+	C, D, Ty float32
+}
+
+// IdentityTransform2D is the identity Transform2D: no scale, rotation, or
+// translation.
+var IdentityTransform2D = Transform2D{A: 1, D: 1}
+
+// BlendMode selects how a drawn pixel combines with the destination pixel
+// already present.
+type BlendMode uint8
+
+const (
+	// BlendSrcOver alpha-composites the source over the destination. This
+	// is the typical blend mode for sprites and UI.
+	BlendSrcOver BlendMode = iota
+
+	// BlendAdditive adds the source color to the destination, useful for
+	// glows and particle effects.
+	BlendAdditive
+
+	// BlendMultiply multiplies the source color into the destination,
+	// useful for shadows and color-grading overlays.
+	BlendMultiply
+)
+
+// String returns the blend mode name for debugging.
+func (m BlendMode) String() string {
+	switch m {
+	case BlendSrcOver:
+		return "SrcOver"
+	case BlendAdditive:
+		return "Additive"
+	case BlendMultiply:
+		return "Multiply"
+	default:
+		return "Unknown"
+	}
+}
+
+// DrawOptions configures a DrawTextureRegion call: an affine transform, a
+// tint color, a blend mode, and an optional rotation pivot. The zero value
+// is not generally useful (it tints to fully transparent black and
+// collapses Transform to the origin); use DefaultDrawOptions to start from
+// an unmodified draw and override only what's needed.
+type DrawOptions struct {
+	// Transform is an affine transform applied to dst before drawing, for
+	// rotation, scale, or skew beyond what src/dst rects alone express.
+	Transform Transform2D
+
+	// Tint multiplies the sampled texture color. Use an opaque white
+	// (1, 1, 1, 1) to draw without tinting.
+	Tint Color
+
+	// Blend selects how the drawn pixels combine with what's already in
+	// the destination.
+	Blend BlendMode
+
+	// Pivot is the point, in dst-rect-local logical pixels, that
+	// Transform's rotation and scale are centered on. Nil centers on
+	// dst's own center.
+	Pivot *Point
+}
+
+// DefaultDrawOptions returns the DrawOptions for an unmodified draw:
+// identity transform, opaque white tint (no color change), and
+// BlendSrcOver.
+func DefaultDrawOptions() DrawOptions {
+	return DrawOptions{
+		Transform: IdentityTransform2D,
+		Tint:      Color{R: 1, G: 1, B: 1, A: 1},
+		Blend:     BlendSrcOver,
+	}
+}
+
+// TextureDrawerExt is an optional capability of TextureDrawer implementers
+// that support partial texture blits with transform, tint, and blending -
+// sprite atlases, 9-slice widgets, and animation - without forcing every
+// backend to implement them, or every caller to allocate a full-size
+// texture for a partial blit.
+//
+// TextureDrawerExt is optional. Use type assertion to check availability:
+//
+//	if ext, ok := drawer.(gpucontext.TextureDrawerExt); ok {
+//	    ext.DrawTextureRegion(tex, src, dst, gpucontext.DefaultDrawOptions())
+//	} else {
+//	    drawer.DrawTexture(tex, float32(dst.X), float32(dst.Y))
+//	}
+type TextureDrawerExt interface {
+	// DrawTextureRegion draws the src subrect of tex (in texture pixel
+	// coordinates) into the dst rect (in drawer pixel coordinates),
+	// applying opts.
+	//
+	// The texture must have been created by TextureCreator from this
+	// drawer.
+	DrawTextureRegion(tex Texture, src Rect, dst Rect, opts DrawOptions) error
+}
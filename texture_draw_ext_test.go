@@ -0,0 +1,89 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "testing"
+
+func TestBlendMode_String(t *testing.T) {
+	tests := []struct {
+		mode BlendMode
+		want string
+	}{
+		{BlendSrcOver, "SrcOver"},
+		{BlendAdditive, "Additive"},
+		{BlendMultiply, "Multiply"},
+		{BlendMode(99), "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.mode.String(); got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultDrawOptions(t *testing.T) {
+	opts := DefaultDrawOptions()
+
+	if opts.Transform != IdentityTransform2D {
+		t.Errorf("Transform = %+v, want identity", opts.Transform)
+	}
+	if opts.Tint != (Color{R: 1, G: 1, B: 1, A: 1}) {
+		t.Errorf("Tint = %+v, want opaque white", opts.Tint)
+	}
+	if opts.Blend != BlendSrcOver {
+		t.Errorf("Blend = %v, want BlendSrcOver", opts.Blend)
+	}
+	if opts.Pivot != nil {
+		t.Errorf("Pivot = %v, want nil", opts.Pivot)
+	}
+}
+
+// mockTexture is a minimal Texture test double.
+type mockTexture struct {
+	width, height int
+}
+
+func (m mockTexture) Width() int  { return m.width }
+func (m mockTexture) Height() int { return m.height }
+
+// mockTextureDrawerExt is used to verify TextureDrawerExt interface.
+type mockTextureDrawerExt struct {
+	lastTex          Texture
+	lastSrc, lastDst Rect
+	lastOpts         DrawOptions
+}
+
+func (m *mockTextureDrawerExt) DrawTextureRegion(tex Texture, src, dst Rect, opts DrawOptions) error {
+	m.lastTex, m.lastSrc, m.lastDst, m.lastOpts = tex, src, dst, opts
+	return nil
+}
+
+// Ensure mockTextureDrawerExt implements TextureDrawerExt.
+var _ TextureDrawerExt = &mockTextureDrawerExt{}
+
+func TestTextureDrawerExt_Interface(t *testing.T) {
+	drawer := &mockTextureDrawerExt{}
+	tex := mockTexture{width: 256, height: 256}
+	src := Rect{X: 0, Y: 0, Width: 32, Height: 32}
+	dst := Rect{X: 10, Y: 20, Width: 64, Height: 64}
+	opts := DefaultDrawOptions()
+
+	if err := drawer.DrawTextureRegion(tex, src, dst, opts); err != nil {
+		t.Fatalf("DrawTextureRegion() error = %v", err)
+	}
+	if drawer.lastTex != tex {
+		t.Errorf("lastTex = %+v, want %+v", drawer.lastTex, tex)
+	}
+	if drawer.lastSrc != src {
+		t.Errorf("lastSrc = %+v, want %+v", drawer.lastSrc, src)
+	}
+	if drawer.lastDst != dst {
+		t.Errorf("lastDst = %+v, want %+v", drawer.lastDst, dst)
+	}
+	if drawer.lastOpts != opts {
+		t.Errorf("lastOpts = %+v, want %+v", drawer.lastOpts, opts)
+	}
+}
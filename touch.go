@@ -89,12 +89,65 @@ type TouchPoint struct {
 	Pressure *float32
 
 	// Radius is the approximate contact radius in logical pixels.
-	// Represents a circular approximation of the contact area.
+	// Represents a circular approximation of the contact area. When
+	// MajorRadius is available but Radius is not reported directly by the
+	// platform, use EffectiveRadius to fall back to MajorRadius.
 	// nil if radius detection is not available.
 	//
 	// Use case: Distinguishing finger vs knuckle touches,
 	// accessibility features for users with larger contact areas.
 	Radius *float32
+
+	// MajorRadius is the radius of the major axis of the elliptical
+	// contact patch, in logical pixels (Chromium's radiusX/radiusY and
+	// Android's TOUCH_MAJOR/TOUCH_MINOR model the same ellipse).
+	// nil if ellipse geometry is not available.
+	MajorRadius *float32
+
+	// MinorRadius is the radius of the minor axis of the elliptical
+	// contact patch, in logical pixels. nil if ellipse geometry is not
+	// available.
+	MinorRadius *float32
+
+	// RotationAngle is the clockwise rotation of the contact ellipse's
+	// major axis, in radians. nil if ellipse geometry is not available.
+	RotationAngle *float32
+
+	// ToolMajor is the radius of the major axis of the tool's footprint
+	// (e.g. a stylus's approach area), in logical pixels, as opposed to
+	// MajorRadius which is the actual contact area. nil if not available.
+	ToolMajor *float32
+
+	// ToolMinor is the radius of the minor axis of the tool's footprint,
+	// in logical pixels. nil if not available.
+	ToolMinor *float32
+
+	// Orientation is the azimuth angle of stylus tilt, in radians,
+	// following the same convention as PointerEvent.Orientation.
+	// nil if not supported or not applicable (e.g. finger touches).
+	Orientation *float32
+
+	// Distance is the hover distance above the surface, in logical pixels.
+	// 0 in contact, greater than 0 when hovering. nil if the device does
+	// not support hover detection.
+	Distance *float32
+
+	// Historical contains intermediate samples for this touch point
+	// batched since the previous delivered event, oldest first. See
+	// PointerEvent.Historical for the rationale; 120Hz+ touch panels can
+	// report samples faster than once per frame. Empty when the platform
+	// has nothing to coalesce.
+	Historical []TouchPoint
+}
+
+// EffectiveRadius returns Radius if set, otherwise falls back to
+// MajorRadius as a circular approximation of the elliptical contact patch.
+// Returns nil if neither is available.
+func (t TouchPoint) EffectiveRadius() *float32 {
+	if t.Radius != nil {
+		return t.Radius
+	}
+	return t.MajorRadius
 }
 
 // TouchEvent represents a touch input event containing one or more touch points.
@@ -116,6 +169,19 @@ type TouchPoint struct {
 //	        // Use distance for zoom...
 //	    }
 //	}
+//
+// Example palm rejection using MajorRadius, the primary use case for the
+// ellipse geometry fields alongside accessibility features:
+//
+//	func handleTouch(ev gpucontext.TouchEvent) {
+//	    const palmThreshold = 20.0 // logical pixels
+//	    for _, tp := range ev.Changed {
+//	        if tp.MajorRadius != nil && *tp.MajorRadius > palmThreshold {
+//	            continue // likely a palm, not a fingertip
+//	        }
+//	        handleFingerTouch(tp)
+//	    }
+//	}
 type TouchEvent struct {
 	// Phase indicates the lifecycle stage of the touches in Changed.
 	Phase TouchPhase
@@ -142,6 +208,12 @@ type TouchEvent struct {
 	Timestamp time.Duration
 }
 
+// Kind returns the lifecycle stage of the touches in Changed.
+//
+// Kind exists for symmetry with PointerEvent.Kind; TouchEvent has no
+// separate "type" concept, so it simply returns Phase.
+func (e TouchEvent) Kind() TouchPhase { return e.Phase }
+
 // TouchEventSource extends EventSource with touch input capabilities.
 // This interface is optional - not all EventSource implementations
 // support touch input (e.g., desktop-only applications).
@@ -0,0 +1,385 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// TapEvent is emitted when a single touch begins and ends within
+// TouchGestureRecognizer.TapMaxDuration without moving more than
+// TapMaxDistance.
+type TapEvent struct {
+	X, Y      float64
+	Timestamp time.Duration
+}
+
+// DoubleTapEvent is emitted in place of a second TapEvent when two taps
+// land within TouchGestureRecognizer.DoubleTapInterval of each other and
+// within TapMaxDistance of one another. The first tap has already been
+// reported as a TapEvent by then.
+type DoubleTapEvent struct {
+	X, Y      float64
+	Timestamp time.Duration
+}
+
+// LongPressEvent is emitted when a single touch stays within
+// TouchGestureRecognizer.TapMaxDistance for at least LongPressDuration.
+// Detection requires the host to call TouchGestureRecognizer.Tick
+// periodically (e.g. once per frame), since the recognizer has no event
+// of its own for "time passing with no touch change".
+type LongPressEvent struct {
+	X, Y      float64
+	Timestamp time.Duration
+}
+
+// PanEvent is emitted for each move of a single touch once it has
+// exceeded TapMaxDistance, carrying both the incremental delta since the
+// last PanEvent and an instantaneous velocity estimated from consecutive
+// touch timestamps.
+type PanEvent struct {
+	X, Y      float64
+	DX, DY    float64
+	VelocityX float64 // logical pixels per second
+	VelocityY float64 // logical pixels per second
+	Timestamp time.Duration
+}
+
+// PinchEvent is emitted while two touches are active, once the distance
+// between them has changed by at least PinchRotateSlop from its value when
+// the second touch began.
+type PinchEvent struct {
+	// Scale is the current inter-touch distance divided by the distance
+	// when the pinch gesture activated. 1.0 = no change.
+	Scale     float64
+	Center    Point
+	Timestamp time.Duration
+}
+
+// RotateEvent is emitted alongside PinchEvent, carrying the change in
+// angle of the vector between the two touches since the gesture activated.
+type RotateEvent struct {
+	// Angle is the rotation in radians since the gesture activated.
+	// Positive = counter-clockwise.
+	Angle     float64
+	Center    Point
+	Timestamp time.Duration
+}
+
+// TouchGestureRecognizer turns the raw TouchEvent stream from a
+// TouchEventSource into higher-level gestures, following the state-machine
+// approach used by mobile UI stacks: idle -> touching -> (tapped |
+// long-pressed | panning), with a second touch promoting a single-touch
+// session into pinch/rotate.
+//
+// All thresholds are exported fields with sane defaults (see
+// NewTouchGestureRecognizer) so UI frameworks can tune them per platform.
+//
+// Long-press detection needs a notion of elapsed wall-clock time beyond
+// what touch events alone provide, so the host must call Tick once per
+// frame (matching the "compute once per frame" pattern used by
+// GestureEvent elsewhere in this package).
+type TouchGestureRecognizer struct {
+	// TapMaxDuration is the longest a touch may last and still count as a
+	// tap. Defaults to 300ms.
+	TapMaxDuration time.Duration
+
+	// TapMaxDistance is the farthest a touch may travel from its start
+	// position and still count as a tap, in logical pixels. Defaults to 10.
+	TapMaxDistance float64
+
+	// DoubleTapInterval is the longest gap between two taps for them to be
+	// combined into a DoubleTapEvent. Defaults to 300ms.
+	DoubleTapInterval time.Duration
+
+	// LongPressDuration is how long a touch must stay within
+	// TapMaxDistance before LongPressEvent fires. Defaults to 500ms.
+	LongPressDuration time.Duration
+
+	// PinchRotateSlop is the change in distance between the two touches,
+	// in logical pixels, required before pinch/rotate gestures activate.
+	// Movement that keeps that distance unchanged (a pure rotation or a
+	// two-finger drag) does not activate them. Defaults to 10.
+	PinchRotateSlop float64
+
+	mu      sync.Mutex
+	single  *touchSession
+	pending map[TouchID]*touchSession // touches not yet part of single/pinch
+	pinch   *pinchSession
+
+	lastTap     Point
+	lastTapAt   time.Duration
+	haveLastTap bool
+
+	onTap       func(TapEvent)
+	onDoubleTap func(DoubleTapEvent)
+	onLongPress func(LongPressEvent)
+	onPan       func(PanEvent)
+	onPinch     func(PinchEvent)
+	onRotate    func(RotateEvent)
+}
+
+type touchSession struct {
+	id        TouchID
+	startPos  Point
+	startTime time.Duration
+	lastPos   Point
+	lastTime  time.Duration
+	moved     bool
+	longFired bool
+}
+
+type pinchSession struct {
+	first, second TouchID
+	startDist     float64
+	startAngle    float64
+	active        bool
+}
+
+// NewTouchGestureRecognizer creates a TouchGestureRecognizer wired to src
+// with the default thresholds described on each field, and registers
+// itself via src.OnTouch.
+func NewTouchGestureRecognizer(src TouchEventSource) *TouchGestureRecognizer {
+	g := &TouchGestureRecognizer{
+		TapMaxDuration:    300 * time.Millisecond,
+		TapMaxDistance:    10,
+		DoubleTapInterval: 300 * time.Millisecond,
+		LongPressDuration: 500 * time.Millisecond,
+		PinchRotateSlop:   10,
+		pending:           make(map[TouchID]*touchSession),
+	}
+	src.OnTouch(g.Feed)
+	return g
+}
+
+// OnTap registers a callback for single-touch tap gestures.
+func (g *TouchGestureRecognizer) OnTap(fn func(TapEvent)) { g.onTap = fn }
+
+// OnDoubleTap registers a callback for double-tap gestures.
+func (g *TouchGestureRecognizer) OnDoubleTap(fn func(DoubleTapEvent)) { g.onDoubleTap = fn }
+
+// OnLongPress registers a callback for long-press gestures. Long-press
+// detection requires periodic calls to Tick.
+func (g *TouchGestureRecognizer) OnLongPress(fn func(LongPressEvent)) { g.onLongPress = fn }
+
+// OnPan registers a callback for single-touch pan (drag) gestures.
+func (g *TouchGestureRecognizer) OnPan(fn func(PanEvent)) { g.onPan = fn }
+
+// OnPinch registers a callback for two-touch pinch gestures.
+func (g *TouchGestureRecognizer) OnPinch(fn func(PinchEvent)) { g.onPinch = fn }
+
+// OnRotate registers a callback for two-touch rotate gestures.
+func (g *TouchGestureRecognizer) OnRotate(fn func(RotateEvent)) { g.onRotate = fn }
+
+// Feed processes one TouchEvent, updating internal state and invoking any
+// registered gesture callbacks. It is normally called automatically via
+// src.OnTouch, but can be called directly for testing or replay.
+func (g *TouchGestureRecognizer) Feed(ev TouchEvent) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	switch ev.Phase {
+	case TouchBegan:
+		for _, tp := range ev.Changed {
+			g.begin(tp, ev.Timestamp, ev.All)
+		}
+	case TouchMoved:
+		for _, tp := range ev.Changed {
+			g.move(tp, ev.Timestamp, ev.All)
+		}
+	case TouchEnded:
+		for _, tp := range ev.Changed {
+			g.end(tp, ev.Timestamp)
+		}
+	case TouchCanceled:
+		for _, tp := range ev.Changed {
+			g.cancel(tp)
+		}
+	}
+}
+
+func (g *TouchGestureRecognizer) begin(tp TouchPoint, ts time.Duration, all []TouchPoint) {
+	session := &touchSession{
+		id:        tp.ID,
+		startPos:  Point{X: tp.X, Y: tp.Y},
+		startTime: ts,
+		lastPos:   Point{X: tp.X, Y: tp.Y},
+		lastTime:  ts,
+	}
+
+	switch {
+	case g.single == nil && g.pinch == nil:
+		g.single = session
+	case g.single != nil && g.pinch == nil:
+		// Second touch promotes the session into a pinch/rotate gesture.
+		first := g.single
+		g.single = nil
+		startDist, startAngle := distanceAndAngle(first.lastPos, session.lastPos)
+		g.pinch = &pinchSession{
+			first:      first.id,
+			second:     session.id,
+			startDist:  startDist,
+			startAngle: startAngle,
+		}
+	default:
+		// A third+ simultaneous touch doesn't participate in any gesture.
+		g.pending[tp.ID] = session
+	}
+}
+
+func (g *TouchGestureRecognizer) move(tp TouchPoint, ts time.Duration, all []TouchPoint) {
+	pos := Point{X: tp.X, Y: tp.Y}
+
+	if g.single != nil && g.single.id == tp.ID {
+		s := g.single
+		dt := (ts - s.lastTime).Seconds()
+		dx, dy := pos.X-s.lastPos.X, pos.Y-s.lastPos.Y
+		dist := distance(s.startPos, pos)
+		if dist > g.TapMaxDistance {
+			s.moved = true
+		}
+		if s.moved && g.onPan != nil {
+			var vx, vy float64
+			if dt > 0 {
+				vx, vy = dx/dt, dy/dt
+			}
+			g.onPan(PanEvent{X: pos.X, Y: pos.Y, DX: dx, DY: dy, VelocityX: vx, VelocityY: vy, Timestamp: ts})
+		}
+		s.lastPos, s.lastTime = pos, ts
+		return
+	}
+
+	if g.pinch != nil && (g.pinch.first == tp.ID || g.pinch.second == tp.ID) {
+		positions := findByIDs(all, g.pinch.first, g.pinch.second)
+		if positions == nil {
+			return
+		}
+		dist, angle := distanceAndAngle(positions[0], positions[1])
+		if !g.pinch.active {
+			if math.Abs(dist-g.pinch.startDist) < g.PinchRotateSlop {
+				return
+			}
+			g.pinch.active = true
+		}
+		center := Point{X: (positions[0].X + positions[1].X) / 2, Y: (positions[0].Y + positions[1].Y) / 2}
+		if g.onPinch != nil && g.pinch.startDist > 0 {
+			g.onPinch(PinchEvent{Scale: dist / g.pinch.startDist, Center: center, Timestamp: ts})
+		}
+		if g.onRotate != nil {
+			g.onRotate(RotateEvent{Angle: normalizeAngleDelta(angle - g.pinch.startAngle), Center: center, Timestamp: ts})
+		}
+		return
+	}
+
+	if s, ok := g.pending[tp.ID]; ok {
+		s.lastPos, s.lastTime = pos, ts
+	}
+}
+
+func (g *TouchGestureRecognizer) end(tp TouchPoint, ts time.Duration) {
+	if g.single != nil && g.single.id == tp.ID {
+		s := g.single
+		g.single = nil
+
+		if s.moved {
+			return
+		}
+		if ts-s.startTime > g.TapMaxDuration {
+			return
+		}
+
+		pos := Point{X: tp.X, Y: tp.Y}
+		if g.haveLastTap && ts-g.lastTapAt <= g.DoubleTapInterval && distance(g.lastTap, pos) <= g.TapMaxDistance {
+			g.haveLastTap = false
+			if g.onDoubleTap != nil {
+				g.onDoubleTap(DoubleTapEvent{X: pos.X, Y: pos.Y, Timestamp: ts})
+			}
+			return
+		}
+
+		g.lastTap, g.lastTapAt, g.haveLastTap = pos, ts, true
+		if g.onTap != nil {
+			g.onTap(TapEvent{X: pos.X, Y: pos.Y, Timestamp: ts})
+		}
+		return
+	}
+
+	if g.pinch != nil && (g.pinch.first == tp.ID || g.pinch.second == tp.ID) {
+		g.pinch = nil
+		return
+	}
+
+	delete(g.pending, tp.ID)
+}
+
+func (g *TouchGestureRecognizer) cancel(tp TouchPoint) {
+	if g.single != nil && g.single.id == tp.ID {
+		g.single = nil
+	}
+	if g.pinch != nil && (g.pinch.first == tp.ID || g.pinch.second == tp.ID) {
+		g.pinch = nil
+	}
+	delete(g.pending, tp.ID)
+}
+
+// Tick drives long-press detection. It should be called once per frame
+// with the current time (using the same reference as TouchEvent.Timestamp).
+// A long-press fires at most once per touch session.
+func (g *TouchGestureRecognizer) Tick(now time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	s := g.single
+	if s == nil || s.moved || s.longFired {
+		return
+	}
+	if now-s.startTime < g.LongPressDuration {
+		return
+	}
+
+	s.longFired = true
+	if g.onLongPress != nil {
+		g.onLongPress(LongPressEvent{X: s.lastPos.X, Y: s.lastPos.Y, Timestamp: now})
+	}
+}
+
+func distance(a, b Point) float64 {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	return math.Sqrt(dx*dx + dy*dy)
+}
+
+func distanceAndAngle(a, b Point) (dist, angle float64) {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	return math.Sqrt(dx*dx + dy*dy), math.Atan2(dy, dx)
+}
+
+func normalizeAngleDelta(a float64) float64 {
+	for a > math.Pi {
+		a -= 2 * math.Pi
+	}
+	for a < -math.Pi {
+		a += 2 * math.Pi
+	}
+	return a
+}
+
+func findByIDs(points []TouchPoint, a, b TouchID) []Point {
+	var pa, pb *Point
+	for i := range points {
+		switch points[i].ID {
+		case a:
+			p := Point{X: points[i].X, Y: points[i].Y}
+			pa = &p
+		case b:
+			p := Point{X: points[i].X, Y: points[i].Y}
+			pb = &p
+		}
+	}
+	if pa == nil || pb == nil {
+		return nil
+	}
+	return []Point{*pa, *pb}
+}
@@ -0,0 +1,229 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeTouchEventSource lets tests fire synthetic TouchEvents directly.
+type fakeTouchEventSource struct {
+	onTouch func(TouchEvent)
+}
+
+func (f *fakeTouchEventSource) OnTouch(fn func(TouchEvent)) { f.onTouch = fn }
+
+func newRecognizerForTest() (*TouchGestureRecognizer, *fakeTouchEventSource) {
+	src := &fakeTouchEventSource{}
+	g := NewTouchGestureRecognizer(src)
+	return g, src
+}
+
+func TestTouchGestureRecognizer_Tap(t *testing.T) {
+	g, src := newRecognizerForTest()
+
+	var got *TapEvent
+	g.OnTap(func(ev TapEvent) { got = &ev })
+
+	src.onTouch(TouchEvent{
+		Phase:     TouchBegan,
+		Changed:   []TouchPoint{{ID: 1, X: 10, Y: 10}},
+		All:       []TouchPoint{{ID: 1, X: 10, Y: 10}},
+		Timestamp: 0,
+	})
+	src.onTouch(TouchEvent{
+		Phase:     TouchEnded,
+		Changed:   []TouchPoint{{ID: 1, X: 11, Y: 9}},
+		Timestamp: 50 * time.Millisecond,
+	})
+
+	if got == nil {
+		t.Fatal("expected TapEvent")
+	}
+	if got.X != 11 || got.Y != 9 {
+		t.Errorf("TapEvent position = (%f, %f), want (11, 9)", got.X, got.Y)
+	}
+}
+
+func TestTouchGestureRecognizer_TapTooSlowIsNotATap(t *testing.T) {
+	g, src := newRecognizerForTest()
+
+	tapped := false
+	g.OnTap(func(TapEvent) { tapped = true })
+
+	src.onTouch(TouchEvent{Phase: TouchBegan, Changed: []TouchPoint{{ID: 1, X: 0, Y: 0}}, Timestamp: 0})
+	src.onTouch(TouchEvent{Phase: TouchEnded, Changed: []TouchPoint{{ID: 1, X: 0, Y: 0}}, Timestamp: time.Second})
+
+	if tapped {
+		t.Error("a touch held longer than TapMaxDuration should not be a tap")
+	}
+}
+
+func TestTouchGestureRecognizer_DoubleTap(t *testing.T) {
+	g, src := newRecognizerForTest()
+
+	var singleTaps, doubleTaps int
+	g.OnTap(func(TapEvent) { singleTaps++ })
+	g.OnDoubleTap(func(DoubleTapEvent) { doubleTaps++ })
+
+	tap := func(ts time.Duration) {
+		src.onTouch(TouchEvent{Phase: TouchBegan, Changed: []TouchPoint{{ID: 1, X: 5, Y: 5}}, Timestamp: ts})
+		src.onTouch(TouchEvent{Phase: TouchEnded, Changed: []TouchPoint{{ID: 1, X: 5, Y: 5}}, Timestamp: ts + 10*time.Millisecond})
+	}
+
+	tap(0)
+	tap(100 * time.Millisecond)
+
+	if singleTaps != 1 {
+		t.Errorf("singleTaps = %d, want 1 (first tap before the double-tap is confirmed)", singleTaps)
+	}
+	if doubleTaps != 1 {
+		t.Errorf("doubleTaps = %d, want 1", doubleTaps)
+	}
+}
+
+func TestTouchGestureRecognizer_Pan(t *testing.T) {
+	g, src := newRecognizerForTest()
+
+	var events []PanEvent
+	g.OnPan(func(ev PanEvent) { events = append(events, ev) })
+
+	src.onTouch(TouchEvent{Phase: TouchBegan, Changed: []TouchPoint{{ID: 1, X: 0, Y: 0}}, Timestamp: 0})
+	src.onTouch(TouchEvent{Phase: TouchMoved, Changed: []TouchPoint{{ID: 1, X: 50, Y: 0}}, Timestamp: 100 * time.Millisecond})
+
+	if len(events) != 1 {
+		t.Fatalf("got %d PanEvents, want 1", len(events))
+	}
+	if events[0].DX != 50 {
+		t.Errorf("DX = %f, want 50", events[0].DX)
+	}
+	if events[0].VelocityX != 500 {
+		t.Errorf("VelocityX = %f, want 500 (50px / 0.1s)", events[0].VelocityX)
+	}
+}
+
+func TestTouchGestureRecognizer_PanSuppressesTap(t *testing.T) {
+	g, src := newRecognizerForTest()
+
+	tapped := false
+	g.OnTap(func(TapEvent) { tapped = true })
+
+	src.onTouch(TouchEvent{Phase: TouchBegan, Changed: []TouchPoint{{ID: 1, X: 0, Y: 0}}, Timestamp: 0})
+	src.onTouch(TouchEvent{Phase: TouchMoved, Changed: []TouchPoint{{ID: 1, X: 100, Y: 0}}, Timestamp: 10 * time.Millisecond})
+	src.onTouch(TouchEvent{Phase: TouchEnded, Changed: []TouchPoint{{ID: 1, X: 100, Y: 0}}, Timestamp: 20 * time.Millisecond})
+
+	if tapped {
+		t.Error("a touch that moved beyond TapMaxDistance should not fire a tap on release")
+	}
+}
+
+func TestTouchGestureRecognizer_PinchAndRotate(t *testing.T) {
+	g, src := newRecognizerForTest()
+
+	var pinches []PinchEvent
+	var rotates []RotateEvent
+	g.OnPinch(func(ev PinchEvent) { pinches = append(pinches, ev) })
+	g.OnRotate(func(ev RotateEvent) { rotates = append(rotates, ev) })
+
+	// Two fingers 100px apart horizontally.
+	src.onTouch(TouchEvent{Phase: TouchBegan, Changed: []TouchPoint{{ID: 1, X: 0, Y: 0}}, All: []TouchPoint{{ID: 1, X: 0, Y: 0}}, Timestamp: 0})
+	src.onTouch(TouchEvent{
+		Phase:     TouchBegan,
+		Changed:   []TouchPoint{{ID: 2, X: 100, Y: 0}},
+		All:       []TouchPoint{{ID: 1, X: 0, Y: 0}, {ID: 2, X: 100, Y: 0}},
+		Timestamp: 0,
+	})
+
+	// Spread to 200px apart: scale should become 2.0.
+	src.onTouch(TouchEvent{
+		Phase:     TouchMoved,
+		Changed:   []TouchPoint{{ID: 2, X: 200, Y: 0}},
+		All:       []TouchPoint{{ID: 1, X: 0, Y: 0}, {ID: 2, X: 200, Y: 0}},
+		Timestamp: 50 * time.Millisecond,
+	})
+
+	if len(pinches) != 1 {
+		t.Fatalf("got %d PinchEvents, want 1", len(pinches))
+	}
+	if pinches[0].Scale != 2.0 {
+		t.Errorf("Scale = %f, want 2.0", pinches[0].Scale)
+	}
+	if len(rotates) != 1 {
+		t.Fatalf("got %d RotateEvents, want 1", len(rotates))
+	}
+	if rotates[0].Angle != 0 {
+		t.Errorf("Angle = %f, want 0 (purely horizontal spread)", rotates[0].Angle)
+	}
+}
+
+func TestTouchGestureRecognizer_LongPress(t *testing.T) {
+	g, src := newRecognizerForTest()
+
+	var got *LongPressEvent
+	g.OnLongPress(func(ev LongPressEvent) { got = &ev })
+
+	src.onTouch(TouchEvent{Phase: TouchBegan, Changed: []TouchPoint{{ID: 1, X: 5, Y: 5}}, Timestamp: 0})
+
+	g.Tick(100 * time.Millisecond)
+	if got != nil {
+		t.Fatal("long press fired too early")
+	}
+
+	g.Tick(600 * time.Millisecond)
+	if got == nil {
+		t.Fatal("expected LongPressEvent after LongPressDuration elapsed")
+	}
+
+	// Long press should only fire once per session.
+	got = nil
+	g.Tick(700 * time.Millisecond)
+	if got != nil {
+		t.Error("long press should not fire twice for the same touch session")
+	}
+}
+
+func TestTouchGestureRecognizer_MoveCancelsLongPress(t *testing.T) {
+	g, src := newRecognizerForTest()
+
+	fired := false
+	g.OnLongPress(func(LongPressEvent) { fired = true })
+
+	src.onTouch(TouchEvent{Phase: TouchBegan, Changed: []TouchPoint{{ID: 1, X: 0, Y: 0}}, Timestamp: 0})
+	src.onTouch(TouchEvent{Phase: TouchMoved, Changed: []TouchPoint{{ID: 1, X: 100, Y: 0}}, Timestamp: 10 * time.Millisecond})
+
+	g.Tick(600 * time.Millisecond)
+	if fired {
+		t.Error("long press should not fire once the touch has moved beyond TapMaxDistance")
+	}
+}
+
+func TestTouchGestureRecognizer_CancelResetsState(t *testing.T) {
+	g, src := newRecognizerForTest()
+
+	tapped := false
+	g.OnTap(func(TapEvent) { tapped = true })
+
+	src.onTouch(TouchEvent{Phase: TouchBegan, Changed: []TouchPoint{{ID: 1, X: 0, Y: 0}}, Timestamp: 0})
+	src.onTouch(TouchEvent{Phase: TouchCanceled, Changed: []TouchPoint{{ID: 1, X: 0, Y: 0}}, Timestamp: 10 * time.Millisecond})
+	src.onTouch(TouchEvent{Phase: TouchEnded, Changed: []TouchPoint{{ID: 1, X: 0, Y: 0}}, Timestamp: 20 * time.Millisecond})
+
+	if tapped {
+		t.Error("a canceled touch should not later fire a tap from a stray TouchEnded for the same ID")
+	}
+}
+
+func TestNewTouchGestureRecognizer_Defaults(t *testing.T) {
+	g, _ := newRecognizerForTest()
+
+	if g.TapMaxDuration != 300*time.Millisecond {
+		t.Errorf("TapMaxDuration = %v, want 300ms", g.TapMaxDuration)
+	}
+	if g.TapMaxDistance != 10 {
+		t.Errorf("TapMaxDistance = %f, want 10", g.TapMaxDistance)
+	}
+	if g.LongPressDuration != 500*time.Millisecond {
+		t.Errorf("LongPressDuration = %v, want 500ms", g.LongPressDuration)
+	}
+}
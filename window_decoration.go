@@ -0,0 +1,182 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import "image"
+
+// HitTestKind classifies a region of the client area for the purposes of
+// window-manager hit-testing, matching the Win32 WM_NCHITTEST result
+// codes (HTCAPTION, HTMINBUTTON, ...) and the equivalent Wayland
+// xdg_toplevel move/resize serials.
+type HitTestKind uint8
+
+const (
+	// HitClient is ordinary client content: no special window-manager
+	// behavior.
+	HitClient HitTestKind = iota
+
+	// HitCaption behaves like a titlebar: draggable to move the window,
+	// double-click to maximize/restore.
+	HitCaption
+
+	// HitMinButton is the minimize button.
+	HitMinButton
+
+	// HitMaxButton is the maximize/restore button. On Windows 11, hovering
+	// this region triggers the snap-layout flyout.
+	HitMaxButton
+
+	// HitCloseButton is the close button.
+	HitCloseButton
+
+	// HitResizeN is the north (top) resize border.
+	HitResizeN
+
+	// HitResizeS is the south (bottom) resize border.
+	HitResizeS
+
+	// HitResizeE is the east (right) resize border.
+	HitResizeE
+
+	// HitResizeW is the west (left) resize border.
+	HitResizeW
+
+	// HitResizeNE is the north-east resize corner.
+	HitResizeNE
+
+	// HitResizeNW is the north-west resize corner.
+	HitResizeNW
+
+	// HitResizeSE is the south-east resize corner.
+	HitResizeSE
+
+	// HitResizeSW is the south-west resize corner.
+	HitResizeSW
+)
+
+// String returns the hit-test kind name for debugging.
+func (k HitTestKind) String() string {
+	switch k {
+	case HitClient:
+		return "Client"
+	case HitCaption:
+		return "Caption"
+	case HitMinButton:
+		return "MinButton"
+	case HitMaxButton:
+		return "MaxButton"
+	case HitCloseButton:
+		return "CloseButton"
+	case HitResizeN:
+		return "ResizeN"
+	case HitResizeS:
+		return "ResizeS"
+	case HitResizeE:
+		return "ResizeE"
+	case HitResizeW:
+		return "ResizeW"
+	case HitResizeNE:
+		return "ResizeNE"
+	case HitResizeNW:
+		return "ResizeNW"
+	case HitResizeSE:
+		return "ResizeSE"
+	case HitResizeSW:
+		return "ResizeSW"
+	default:
+		return "Unknown"
+	}
+}
+
+// HitTestRegion declares that Rect, in physical pixels relative to the
+// window's client area, should behave as Kind for window-manager
+// hit-testing purposes.
+type HitTestRegion struct {
+	Rect image.Rectangle
+	Kind HitTestKind
+}
+
+// DecorationProvider extends WindowProvider with client-side decorations:
+// an app that draws its own titlebar and window controls declares which
+// regions of its client area act as the titlebar, resize border, and
+// min/max/close buttons, so the OS window manager can still drive
+// dragging, double-click-to-maximize, Windows 11 snap-layout flyouts, and
+// Wayland's xdg_toplevel move/resize serials.
+//
+// DecorationProvider is optional. Use type assertion to check availability:
+//
+//	if dp, ok := provider.(gpucontext.DecorationProvider); ok {
+//	    dp.SetClientDecorations(true)
+//	    dp.SetHitTestRegions([]gpucontext.HitTestRegion{
+//	        {Rect: titlebarRect, Kind: gpucontext.HitCaption},
+//	        {Rect: maxButtonRect, Kind: gpucontext.HitMaxButton},
+//	    })
+//	}
+type DecorationProvider interface {
+	// SetClientDecorations enables or disables the platform's own window
+	// decorations (titlebar, borders). Pass true when the app intends to
+	// draw its own via SetHitTestRegions.
+	SetClientDecorations(enabled bool)
+
+	// SetHitTestRegions declares how the client area should be
+	// hit-tested by the window manager. Regions not covered by any entry
+	// are treated as HitClient. Replaces any previously set regions.
+	SetHitTestRegions(regions []HitTestRegion)
+
+	// SetTitle sets the window title, used by the OS taskbar/dock/
+	// window-switcher even when client decorations are disabled.
+	SetTitle(title string)
+
+	// Minimize minimizes the window.
+	Minimize()
+
+	// Maximize maximizes the window.
+	Maximize()
+
+	// Restore restores the window from minimized or maximized state.
+	Restore()
+
+	// StartMove begins an interactive window move, as if the user had
+	// pressed the mouse button on a HitCaption region. Typically called
+	// from a pointer-down handler on the app's custom titlebar.
+	StartMove()
+
+	// StartResize begins an interactive window resize from the given
+	// edge or corner, as if the user had pressed the mouse button on the
+	// corresponding HitResize* region. edge must be one of the
+	// HitResizeN/S/E/W/NE/NW/SE/SW constants.
+	StartResize(edge HitTestKind)
+}
+
+// NullDecorationProvider implements DecorationProvider with no-op
+// behavior. Used for testing and platforms without client-side
+// decoration support.
+type NullDecorationProvider struct{}
+
+// SetClientDecorations does nothing.
+func (NullDecorationProvider) SetClientDecorations(bool) {}
+
+// SetHitTestRegions does nothing.
+func (NullDecorationProvider) SetHitTestRegions([]HitTestRegion) {}
+
+// SetTitle does nothing.
+func (NullDecorationProvider) SetTitle(string) {}
+
+// Minimize does nothing.
+func (NullDecorationProvider) Minimize() {}
+
+// Maximize does nothing.
+func (NullDecorationProvider) Maximize() {}
+
+// Restore does nothing.
+func (NullDecorationProvider) Restore() {}
+
+// StartMove does nothing.
+func (NullDecorationProvider) StartMove() {}
+
+// StartResize does nothing.
+func (NullDecorationProvider) StartResize(HitTestKind) {}
+
+// Ensure NullDecorationProvider implements DecorationProvider.
+var _ DecorationProvider = NullDecorationProvider{}
@@ -0,0 +1,67 @@
+// Copyright 2026 The gogpu Authors
+// SPDX-License-Identifier: MIT
+
+package gpucontext
+
+import (
+	"image"
+	"testing"
+)
+
+func TestHitTestKind_String(t *testing.T) {
+	tests := []struct {
+		kind HitTestKind
+		want string
+	}{
+		{HitClient, "Client"},
+		{HitCaption, "Caption"},
+		{HitMinButton, "MinButton"},
+		{HitMaxButton, "MaxButton"},
+		{HitCloseButton, "CloseButton"},
+		{HitResizeN, "ResizeN"},
+		{HitResizeS, "ResizeS"},
+		{HitResizeE, "ResizeE"},
+		{HitResizeW, "ResizeW"},
+		{HitResizeNE, "ResizeNE"},
+		{HitResizeNW, "ResizeNW"},
+		{HitResizeSE, "ResizeSE"},
+		{HitResizeSW, "ResizeSW"},
+		{HitTestKind(99), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := tt.kind.String()
+			if got != tt.want {
+				t.Errorf("String(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHitTestRegion_Fields(t *testing.T) {
+	region := HitTestRegion{Rect: image.Rect(0, 0, 100, 32), Kind: HitCaption}
+
+	if region.Rect.Dx() != 100 || region.Rect.Dy() != 32 {
+		t.Errorf("Rect = %v, want 100x32", region.Rect)
+	}
+	if region.Kind != HitCaption {
+		t.Errorf("Kind = %v, want HitCaption", region.Kind)
+	}
+}
+
+func TestNullDecorationProvider(t *testing.T) {
+	var dp DecorationProvider = NullDecorationProvider{}
+
+	// All calls should be accepted without panic.
+	dp.SetClientDecorations(true)
+	dp.SetHitTestRegions([]HitTestRegion{{Rect: image.Rect(0, 0, 100, 32), Kind: HitCaption}})
+	dp.SetTitle("test")
+	dp.Minimize()
+	dp.Maximize()
+	dp.Restore()
+	dp.StartMove()
+	dp.StartResize(HitResizeSE)
+
+	var _ DecorationProvider = NullDecorationProvider{}
+}